@@ -22,25 +22,29 @@ type Emojo struct {
 	Shortcode   string `json:"Shortcode"`
 }
 
-func createEmojoCodeMap() (map[string]string, error) {
+func createEmojoCodeMap() (map[string]string, map[string][]string, error) {
 	res, err := http.Get(emojoV5DBJsonURL)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 
 	emojiFile, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var gs []Emojo
 	if err := json.Unmarshal(emojiFile, &gs); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	emojiCodeMap := make(map[string]string)
+	keywords := make(map[string][]string)
 	for _, gemoji := range gs {
+		if len(gemoji.Emoji) > 0 {
+			keywords[gemoji.Emoji] = append(keywords[gemoji.Emoji], splitTags(gemoji.Tags)...)
+		}
 		shortCode := strings.Replace(gemoji.Shortcode, ":", "", 2)
 		if len(shortCode) == 0 || len(gemoji.Emoji) == 0 {
 			continue
@@ -49,5 +53,12 @@ func createEmojoCodeMap() (map[string]string, error) {
 		emojiCodeMap[shortCode] = fmt.Sprintf("%+q", strings.ToLower(code))
 	}
 
-	return emojiCodeMap, nil
+	return emojiCodeMap, keywords, nil
+}
+
+// splitTags splits the tags of an emojo entry, which are separated by "|" or ","
+func splitTags(tags string) []string {
+	return strings.FieldsFunc(tags, func(r rune) bool {
+		return r == '|' || r == ','
+	})
 }
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+)
+
+const (
+	emojiDataURL         = "https://unicode.org/Public/UCD/latest/ucd/emoji/emoji-data.txt"
+	emojiSequencesURL    = "https://unicode.org/Public/emoji/latest/emoji-sequences.txt"
+	emojiZWJSequencesURL = "https://unicode.org/Public/emoji/latest/emoji-zwj-sequences.txt"
+)
+
+// emojiProperties are the properties of emoji-data.txt written to emoji_properties.go
+var emojiProperties = []string{
+	"Emoji",
+	"Emoji_Presentation",
+	"Emoji_Modifier",
+	"Emoji_Modifier_Base",
+	"Emoji_Component",
+	"Extended_Pictographic",
+}
+
+// CodepointRange is a range of code points, both ends included
+type CodepointRange struct {
+	Lo, Hi rune
+}
+
+// PropertyTable is an emoji property in emoji_properties.go
+type PropertyTable struct {
+	Name        string
+	R16, R32    []CodepointRange
+	LatinOffset int
+}
+
+// PropertiesTemplateData emoji_properties.go template
+type PropertiesTemplateData struct {
+	PkgName      string
+	Properties   []*PropertyTable
+	RGISequences []string
+}
+
+const templatePropertiesCode = `
+package {{.PkgName}}
+
+import (
+	"unicode"
+)
+
+// NOTE: THIS FILE WAS PRODUCED BY THE
+// EMOJICODEMAP CODE GENERATION TOOL (github.com/kyokomi/emoji/cmd/generateEmojiCodeMap)
+// DO NOT EDIT
+
+var emojiPropertyTables = map[string]*unicode.RangeTable{
+	{{range .Properties}}{{printf "%q" .Name}}: {
+		R16: []unicode.Range16{
+			{{range .R16}}{Lo: {{printf "%#04x" .Lo}}, Hi: {{printf "%#04x" .Hi}}, Stride: 1},
+		{{end}}},
+		R32: []unicode.Range32{
+			{{range .R32}}{Lo: {{printf "%#04x" .Lo}}, Hi: {{printf "%#04x" .Hi}}, Stride: 1},
+		{{end}}},
+		LatinOffset: {{.LatinOffset}},
+	},
+{{end}}}
+
+var rgiEmojiSequenceList = []string{
+	{{range .RGISequences}}{{printf "%+q" .}},
+{{end}}}
+`
+
+func getUnicodeData(url string, parse func(io.Reader) error) error {
+	res, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != 200 {
+		return fmt.Errorf("status code error: %d %s", res.StatusCode, res.Status)
+	}
+
+	return parse(res.Body)
+}
+
+// createEmojiPropertyData returns the emoji properties of emoji-data.txt and the RGI
+// emoji sequences of emoji-sequences.txt and emoji-zwj-sequences.txt
+func createEmojiPropertyData() (map[string][]CodepointRange, []string, error) {
+	var properties map[string][]CodepointRange
+	err := getUnicodeData(emojiDataURL, func(r io.Reader) (err error) {
+		properties, err = parseEmojiData(r)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sequences []string
+	for _, url := range []string{emojiSequencesURL, emojiZWJSequencesURL} {
+		err := getUnicodeData(url, func(r io.Reader) error {
+			s, err := parseEmojiSequences(r)
+			sequences = append(sequences, s...)
+			return err
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return properties, sequences, nil
+}
+
+// parseEmojiData parses the code points of each property of emoji-data.txt.
+//
+//	231A..231B    ; Emoji_Presentation   # E0.6   [2] (⌚..⌛)    watch..hourglass done
+func parseEmojiData(r io.Reader) (map[string][]CodepointRange, error) {
+	properties := make(map[string][]CodepointRange)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		codes, property, ok := strings.Cut(line, ";")
+		if !ok {
+			return nil, fmt.Errorf("emoji-data: no property in %q", line)
+		}
+		lo, hi, err := parseCodepointRange(codes)
+		if err != nil {
+			return nil, fmt.Errorf("emoji-data: %w", err)
+		}
+		property = strings.TrimSpace(property)
+		properties[property] = append(properties[property], CodepointRange{lo, hi})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return properties, nil
+}
+
+// parseEmojiSequences parses the sequences of emoji-sequences.txt and
+// emoji-zwj-sequences.txt. A range of code points stands for each of them.
+//
+//	231A..231B    ; Basic_Emoji              ; watch                  # E0.6   [2] (⌚..⌛)
+//	0023 FE0F 20E3; Emoji_Keycap_Sequence    ; keycap: \x{23}         # E0.6   [1]
+func parseEmojiSequences(r io.Reader) ([]string, error) {
+	var sequences []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		codes, _, ok := strings.Cut(line, ";")
+		if !ok {
+			return nil, fmt.Errorf("emoji-sequences: no type in %q", line)
+		}
+		if strings.Contains(codes, "..") {
+			lo, hi, err := parseCodepointRange(codes)
+			if err != nil {
+				return nil, fmt.Errorf("emoji-sequences: %w", err)
+			}
+			for r := lo; r <= hi; r++ {
+				sequences = append(sequences, string(r))
+			}
+			continue
+		}
+		char, err := UnifiedToChar(strings.Join(strings.Fields(codes), "-"))
+		if err != nil {
+			return nil, err
+		}
+		sequences = append(sequences, char)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return sequences, nil
+}
+
+// parseCodepointRange parses a code point such as "231A" or a range such as
+// "231A..231B"
+func parseCodepointRange(s string) (rune, rune, error) {
+	loText, hiText, isRange := strings.Cut(strings.TrimSpace(s), "..")
+	lo, err := strconv.ParseUint(loText, 16, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid code point %q", s)
+	}
+	hi := lo
+	if isRange {
+		if hi, err = strconv.ParseUint(hiText, 16, 32); err != nil || hi < lo {
+			return 0, 0, fmt.Errorf("invalid code point range %q", s)
+		}
+	}
+	return rune(lo), rune(hi), nil
+}
+
+// createPropertyTable merges the ranges of a property and splits them into the 16-bit
+// and 32-bit ranges of a unicode.RangeTable
+func createPropertyTable(name string, ranges []CodepointRange) *PropertyTable {
+	sorted := append([]CodepointRange(nil), ranges...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Lo < sorted[j].Lo })
+
+	var merged []CodepointRange
+	for _, cr := range sorted {
+		if n := len(merged); n > 0 && cr.Lo <= merged[n-1].Hi+1 {
+			merged[n-1].Hi = max(merged[n-1].Hi, cr.Hi)
+			continue
+		}
+		merged = append(merged, cr)
+	}
+
+	table := &PropertyTable{Name: name}
+	for _, cr := range merged {
+		if cr.Lo <= 0xFFFF && cr.Hi > 0xFFFF {
+			table.R16 = append(table.R16, CodepointRange{cr.Lo, 0xFFFF})
+			cr.Lo = 0x10000
+		}
+		if cr.Hi <= 0xFFFF {
+			table.R16 = append(table.R16, cr)
+			if cr.Hi <= 0xFF {
+				table.LatinOffset++
+			}
+			continue
+		}
+		table.R32 = append(table.R32, cr)
+	}
+	return table
+}
+
+func createPropertiesSource(pkgName string, properties map[string][]CodepointRange, sequences []string) ([]byte, error) {
+	data := PropertiesTemplateData{PkgName: pkgName}
+	for _, name := range emojiProperties {
+		ranges, ok := properties[name]
+		if !ok {
+			return nil, fmt.Errorf("emoji-data: no %s property", name)
+		}
+		data.Properties = append(data.Properties, createPropertyTable(name, ranges))
+	}
+
+	seen := make(map[string]bool)
+	for _, seq := range sequences {
+		if !utf8.ValidString(seq) || seen[seq] {
+			continue
+		}
+		seen[seq] = true
+		data.RGISequences = append(data.RGISequences, seq)
+	}
+	sort.Strings(data.RGISequences)
+
+	var buf bytes.Buffer
+	t := template.Must(template.New("template").Parse(templatePropertiesCode))
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	bts, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("gofmt: %s", err)
+	}
+
+	return bts, nil
+}
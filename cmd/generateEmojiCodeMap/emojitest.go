@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const emojiTestURL = "https://unicode.org/Public/emoji/latest/emoji-test.txt"
+
+// EmojiTest is an emoji listed in emoji-test.txt
+type EmojiTest struct {
+	Char     string
+	Name     string
+	Group    string
+	Subgroup string
+	Version  string
+	Status   string
+}
+
+func createEmojiTestData() ([]*EmojiTest, error) {
+	res, err := http.Get(emojiTestURL)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("status code error: %d %s", res.StatusCode, res.Status)
+	}
+
+	return parseEmojiTest(res.Body)
+}
+
+// parseEmojiTest parses the fully-qualified emoji and the components of emoji-test.txt,
+// in the order they are listed in.
+//
+//	# group: Smileys & Emotion
+//	# subgroup: face-smiling
+//	1F600 ; fully-qualified # 😀 E1.0 grinning face
+func parseEmojiTest(r io.Reader) ([]*EmojiTest, error) {
+	var emojis []*EmojiTest
+	var group, subgroup string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if v, ok := strings.CutPrefix(line, "# group:"); ok {
+			group = strings.TrimSpace(v)
+			continue
+		}
+		if v, ok := strings.CutPrefix(line, "# subgroup:"); ok {
+			subgroup = strings.TrimSpace(v)
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields, comment, ok := strings.Cut(line, "#")
+		if !ok {
+			return nil, fmt.Errorf("emoji-test: no comment in %q", line)
+		}
+		codes, status, ok := strings.Cut(fields, ";")
+		if !ok {
+			return nil, fmt.Errorf("emoji-test: no status in %q", line)
+		}
+		status = strings.TrimSpace(status)
+		if status != "fully-qualified" && status != "component" {
+			continue
+		}
+		char, err := UnifiedToChar(strings.Join(strings.Fields(codes), "-"))
+		if err != nil {
+			return nil, err
+		}
+
+		// the comment is the emoji, its version and its name
+		parts := strings.SplitN(strings.TrimSpace(comment), " ", 3)
+		if len(parts) != 3 || !strings.HasPrefix(parts[1], "E") {
+			return nil, fmt.Errorf("emoji-test: unexpected comment in %q", line)
+		}
+		emojis = append(emojis, &EmojiTest{
+			Char:     char,
+			Name:     parts[2],
+			Group:    group,
+			Subgroup: subgroup,
+			Version:  strings.TrimPrefix(parts[1], "E"),
+			Status:   status,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return emojis, nil
+}
@@ -18,24 +18,25 @@ type GemojiEmoji struct {
 	Tags        []string `json:"tags"`
 }
 
-func createGemojiCodeMap() (map[string]string, error) {
+func createGemojiCodeMap() (map[string]string, map[string][]string, error) {
 	res, err := http.Get(gemojiDBJsonURL)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 
 	emojiFile, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var gs []GemojiEmoji
 	if err := json.Unmarshal(emojiFile, &gs); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	emojiCodeMap := make(map[string]string)
+	keywords := make(map[string][]string)
 	for _, gemoji := range gs {
 		for _, a := range gemoji.Aliases {
 			if len(a) == 0 || len(gemoji.Emoji) == 0 {
@@ -44,7 +45,10 @@ func createGemojiCodeMap() (map[string]string, error) {
 			code := gemoji.Emoji
 			emojiCodeMap[a] = fmt.Sprintf("%+q", strings.ToLower(code))
 		}
+		if len(gemoji.Emoji) > 0 {
+			keywords[gemoji.Emoji] = append(keywords[gemoji.Emoji], gemoji.Tags...)
+		}
 	}
 
-	return emojiCodeMap, nil
+	return emojiCodeMap, keywords, nil
 }
@@ -14,12 +14,16 @@ import (
 
 var pkgName string
 var fileName string
+var metadataFileName string
+var propertiesFileName string
 
 func init() {
 	log.SetFlags(log.Llongfile)
 
 	flag.StringVar(&pkgName, "pkg", "emoji", "output package")
 	flag.StringVar(&fileName, "o", "../../emoji_codemap.go", "output file")
+	flag.StringVar(&metadataFileName, "meta", "../../emoji_metadata.go", "metadata output file")
+	flag.StringVar(&propertiesFileName, "props", "../../emoji_properties.go", "emoji properties output file")
 	flag.Parse()
 }
 
@@ -66,35 +70,43 @@ func emojiRevCode() map[string][]string {
 }
 `
 
-func createCodeMap() (map[string]string, map[string][]string, error) {
+// createCodeMap returns the shortcodes of each emoji, and the keywords of each emoji
+// character collected along the way
+func createCodeMap() (map[string]string, map[string][]string, map[string][]string, error) {
 	log.Printf("creating gemoji code map")
-	emojiCodeMap, err := createGemojiCodeMap()
+	emojiCodeMap, keywords, err := createGemojiCodeMap()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	log.Printf("creating emojo code map")
-	emojoCodeMap, err := createEmojoCodeMap()
+	emojoCodeMap, emojoKeywords, err := createEmojoCodeMap()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	for k, v := range emojoCodeMap {
 		emojiCodeMap[k] = v
 	}
+	for k, v := range emojoKeywords {
+		keywords[k] = append(keywords[k], v...)
+	}
 
 	log.Printf("creating unicode code map")
-	unicodeorgCodeMap, err := createUnicodeorgMap()
+	unicodeorgCodeMap, unicodeorgKeywords, err := createUnicodeorgMap()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	for k, v := range unicodeorgCodeMap {
 		emojiCodeMap[k] = v
 	}
+	for k, v := range unicodeorgKeywords {
+		keywords[k] = append(keywords[k], v...)
+	}
 
 	log.Printf("creating emoji code map")
 	emojiDataCodeMap, err := createEmojiDataCodeMap()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	for k, v := range emojiDataCodeMap {
 		emojiCodeMap[k] = v
@@ -121,7 +133,7 @@ func createCodeMap() (map[string]string, map[string][]string, error) {
 		})
 	}
 
-	return emojiCodeMap, emojiRevCodeMap, nil
+	return emojiCodeMap, emojiRevCodeMap, keywords, nil
 }
 
 func createCodeMapSource(pkgName string, emojiCodeMap map[string]string, emojiRevCodeMap map[string][]string) ([]byte, error) {
@@ -144,8 +156,21 @@ func createCodeMapSource(pkgName string, emojiCodeMap map[string]string, emojiRe
 	return bts, nil
 }
 
+func writeSource(fileName string, source []byte) error {
+	os.Remove(fileName)
+
+	file, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(source)
+	return err
+}
+
 func main() {
-	emojiCodeMap, emojiRevCodeMap, err := createCodeMap()
+	emojiCodeMap, emojiRevCodeMap, keywords, err := createCodeMap()
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -154,16 +179,35 @@ func main() {
 	if err != nil {
 		log.Fatalln(err)
 	}
+	if err := writeSource(fileName, codeMapSource); err != nil {
+		log.Fatalln(err)
+	}
 
-	os.Remove(fileName)
+	log.Printf("creating emoji metadata")
+	emojiTests, err := createEmojiTestData()
+	if err != nil {
+		log.Fatalln(err)
+	}
 
-	file, err := os.Create(fileName)
+	metadataSource, err := createMetadataSource(pkgName, createMetadata(emojiTests, keywords))
 	if err != nil {
 		log.Fatalln(err)
 	}
-	defer file.Close()
+	if err := writeSource(metadataFileName, metadataSource); err != nil {
+		log.Fatalln(err)
+	}
 
-	if _, err := file.Write(codeMapSource); err != nil {
+	log.Printf("creating emoji properties")
+	properties, sequences, err := createEmojiPropertyData()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	propertiesSource, err := createPropertiesSource(pkgName, properties, sequences)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err := writeSource(propertiesFileName, propertiesSource); err != nil {
 		log.Fatalln(err)
 	}
 }
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// EmojiMetadata is the metadata of an emoji in emoji_metadata.go
+type EmojiMetadata struct {
+	Char     string
+	Name     string
+	Group    string
+	Subgroup string
+	Keywords []string
+	Version  string
+	SkinTone bool
+}
+
+// MetadataTemplateData emoji_metadata.go template
+type MetadataTemplateData struct {
+	PkgName string
+	Emoji   []*EmojiMetadata
+}
+
+const templateMetadataCode = `
+package {{.PkgName}}
+
+import (
+	"sync"
+)
+
+// NOTE: THIS FILE WAS PRODUCED BY THE
+// EMOJICODEMAP CODE GENERATION TOOL (github.com/kyokomi/emoji/cmd/generateEmojiCodeMap)
+// DO NOT EDIT
+
+var emojiDataList []emojiData
+var emojiDataListInitOnce = sync.Once{}
+
+func emojiDataTable() []emojiData {
+	emojiDataListInitOnce.Do(func() {
+		emojiDataList = []emojiData{
+			{{range .Emoji}}{ {{printf "%+q" .Char}}, {{printf "%q" .Name}}, {{printf "%q" .Group}}, {{printf "%q" .Subgroup}}, []string{ {{range .Keywords}}{{printf "%q" .}}, {{end}} }, {{printf "%q" .Version}}, {{.SkinTone}} },
+		{{end}}}
+	})
+	return emojiDataList
+}
+`
+
+// keywordKey returns the key of an emoji in the keyword map, which is the same with
+// or without variation selectors
+func keywordKey(char string) string {
+	return strings.ReplaceAll(char, "\ufe0f", "")
+}
+
+// createMetadata merges the keywords collected from the emoji databases into the
+// emoji of emoji-test.txt. The words of the subgroup are keywords as well.
+func createMetadata(emojiTests []*EmojiTest, keywords map[string][]string) []*EmojiMetadata {
+	normalized := make(map[string][]string, len(keywords))
+	for char, words := range keywords {
+		normalized[keywordKey(char)] = append(normalized[keywordKey(char)], words...)
+	}
+
+	// emoji with skin tones are listed as "name: medium skin tone"
+	skinTone := make(map[string]bool)
+	for _, e := range emojiTests {
+		if name, variant, ok := strings.Cut(e.Name, ": "); ok && strings.Contains(variant, "skin tone") {
+			skinTone[name] = true
+		}
+	}
+
+	metadata := make([]*EmojiMetadata, 0, len(emojiTests))
+	for _, e := range emojiTests {
+		words := append(strings.Split(e.Subgroup, "-"), normalized[keywordKey(e.Char)]...)
+
+		seen := make(map[string]bool)
+		var emojiKeywords []string
+		for _, word := range words {
+			word = strings.ToLower(strings.TrimSpace(word))
+			if word == "" || seen[word] {
+				continue
+			}
+			seen[word] = true
+			emojiKeywords = append(emojiKeywords, word)
+		}
+		sort.Strings(emojiKeywords)
+
+		metadata = append(metadata, &EmojiMetadata{
+			Char:     e.Char,
+			Name:     e.Name,
+			Group:    e.Group,
+			Subgroup: e.Subgroup,
+			Keywords: emojiKeywords,
+			Version:  e.Version,
+			SkinTone: skinTone[e.Name],
+		})
+	}
+	return metadata
+}
+
+func createMetadataSource(pkgName string, metadata []*EmojiMetadata) ([]byte, error) {
+	var buf bytes.Buffer
+	t := template.Must(template.New("template").Parse(templateMetadataCode))
+	if err := t.Execute(&buf, MetadataTemplateData{PkgName: pkgName, Emoji: metadata}); err != nil {
+		return nil, err
+	}
+
+	bts, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("gofmt: %s", err)
+	}
+
+	return bts, nil
+}
@@ -13,14 +13,14 @@ import (
 
 const unicodeorgURL = "https://www.unicode.org/emoji/charts/emoji-list.html"
 
-func createUnicodeorgMap() (map[string]string, error) {
+func createUnicodeorgMap() (map[string]string, map[string][]string, error) {
 	res, err := http.Get(unicodeorgURL)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 	if res.StatusCode != 200 {
-		return nil, fmt.Errorf("status code error: %d %s", res.StatusCode, res.Status)
+		return nil, nil, fmt.Errorf("status code error: %d %s", res.StatusCode, res.Status)
 	}
 
 	return generateUnicodeorgCodeMap(res.Body)
@@ -42,10 +42,10 @@ var shortNameReplaces = []string{
 	"”", "", // \U+201D
 }
 
-func generateUnicodeorgCodeMap(body io.ReadCloser) (map[string]string, error) {
+func generateUnicodeorgCodeMap(body io.ReadCloser) (map[string]string, map[string][]string, error) {
 	doc, err := goquery.NewDocumentFromReader(body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var emojis []*UnicodeorgEmoji
@@ -79,14 +79,17 @@ func generateUnicodeorgCodeMap(body io.ReadCloser) (map[string]string, error) {
 		unicodeEmoji.Code = sb.String()
 		shortName := strings.NewReplacer(shortNameReplaces...).Replace(cols[3])
 		unicodeEmoji.ShortName = strings.Replace(strings.TrimSpace(shortName), " ", "_", -1)
-		unicodeEmoji.OtherKeywords = strings.Fields(cols[4])
+		// keywords are separated by "|"
+		unicodeEmoji.OtherKeywords = splitTags(cols[4])
 		emojis = append(emojis, &unicodeEmoji)
 	})
 
 	emojiCodeMap := make(map[string]string)
+	keywords := make(map[string][]string)
 	for _, emoji := range emojis {
 		emojiCodeMap[emoji.ShortName] = fmt.Sprintf("%+q", emoji.Code)
+		keywords[emoji.Code] = append(keywords[emoji.Code], emoji.OtherKeywords...)
 	}
 
-	return emojiCodeMap, nil
+	return emojiCodeMap, keywords, nil
 }
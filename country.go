@@ -0,0 +1,372 @@
+package emoji
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// country is a region or subdivision that has a flag emoji.
+type country struct {
+	code string
+	name string
+}
+
+// countries lists the ISO 3166-1 alpha-2 codes that have a flag emoji, plus the few
+// codes Unicode reserves for regions such as EU and UN, and the ISO 3166-2
+// subdivisions with a flag emoji. Names are the CLDR English short names.
+var countries = []country{
+	{"AC", "Ascension Island"},
+	{"AD", "Andorra"},
+	{"AE", "United Arab Emirates"},
+	{"AF", "Afghanistan"},
+	{"AG", "Antigua & Barbuda"},
+	{"AI", "Anguilla"},
+	{"AL", "Albania"},
+	{"AM", "Armenia"},
+	{"AO", "Angola"},
+	{"AQ", "Antarctica"},
+	{"AR", "Argentina"},
+	{"AS", "American Samoa"},
+	{"AT", "Austria"},
+	{"AU", "Australia"},
+	{"AW", "Aruba"},
+	{"AX", "Åland Islands"},
+	{"AZ", "Azerbaijan"},
+	{"BA", "Bosnia & Herzegovina"},
+	{"BB", "Barbados"},
+	{"BD", "Bangladesh"},
+	{"BE", "Belgium"},
+	{"BF", "Burkina Faso"},
+	{"BG", "Bulgaria"},
+	{"BH", "Bahrain"},
+	{"BI", "Burundi"},
+	{"BJ", "Benin"},
+	{"BL", "St. Barthélemy"},
+	{"BM", "Bermuda"},
+	{"BN", "Brunei"},
+	{"BO", "Bolivia"},
+	{"BQ", "Caribbean Netherlands"},
+	{"BR", "Brazil"},
+	{"BS", "Bahamas"},
+	{"BT", "Bhutan"},
+	{"BV", "Bouvet Island"},
+	{"BW", "Botswana"},
+	{"BY", "Belarus"},
+	{"BZ", "Belize"},
+	{"CA", "Canada"},
+	{"CC", "Cocos (Keeling) Islands"},
+	{"CD", "Congo - Kinshasa"},
+	{"CF", "Central African Republic"},
+	{"CG", "Congo - Brazzaville"},
+	{"CH", "Switzerland"},
+	{"CI", "Côte d’Ivoire"},
+	{"CK", "Cook Islands"},
+	{"CL", "Chile"},
+	{"CM", "Cameroon"},
+	{"CN", "China"},
+	{"CO", "Colombia"},
+	{"CP", "Clipperton Island"},
+	{"CR", "Costa Rica"},
+	{"CU", "Cuba"},
+	{"CV", "Cape Verde"},
+	{"CW", "Curaçao"},
+	{"CX", "Christmas Island"},
+	{"CY", "Cyprus"},
+	{"CZ", "Czechia"},
+	{"DE", "Germany"},
+	{"DG", "Diego Garcia"},
+	{"DJ", "Djibouti"},
+	{"DK", "Denmark"},
+	{"DM", "Dominica"},
+	{"DO", "Dominican Republic"},
+	{"DZ", "Algeria"},
+	{"EA", "Ceuta & Melilla"},
+	{"EC", "Ecuador"},
+	{"EE", "Estonia"},
+	{"EG", "Egypt"},
+	{"EH", "Western Sahara"},
+	{"ER", "Eritrea"},
+	{"ES", "Spain"},
+	{"ET", "Ethiopia"},
+	{"EU", "European Union"},
+	{"FI", "Finland"},
+	{"FJ", "Fiji"},
+	{"FK", "Falkland Islands"},
+	{"FM", "Micronesia"},
+	{"FO", "Faroe Islands"},
+	{"FR", "France"},
+	{"GA", "Gabon"},
+	{"GB", "United Kingdom"},
+	{"GD", "Grenada"},
+	{"GE", "Georgia"},
+	{"GF", "French Guiana"},
+	{"GG", "Guernsey"},
+	{"GH", "Ghana"},
+	{"GI", "Gibraltar"},
+	{"GL", "Greenland"},
+	{"GM", "Gambia"},
+	{"GN", "Guinea"},
+	{"GP", "Guadeloupe"},
+	{"GQ", "Equatorial Guinea"},
+	{"GR", "Greece"},
+	{"GS", "South Georgia & South Sandwich Islands"},
+	{"GT", "Guatemala"},
+	{"GU", "Guam"},
+	{"GW", "Guinea-Bissau"},
+	{"GY", "Guyana"},
+	{"HK", "Hong Kong SAR China"},
+	{"HM", "Heard & McDonald Islands"},
+	{"HN", "Honduras"},
+	{"HR", "Croatia"},
+	{"HT", "Haiti"},
+	{"HU", "Hungary"},
+	{"IC", "Canary Islands"},
+	{"ID", "Indonesia"},
+	{"IE", "Ireland"},
+	{"IL", "Israel"},
+	{"IM", "Isle of Man"},
+	{"IN", "India"},
+	{"IO", "British Indian Ocean Territory"},
+	{"IQ", "Iraq"},
+	{"IR", "Iran"},
+	{"IS", "Iceland"},
+	{"IT", "Italy"},
+	{"JE", "Jersey"},
+	{"JM", "Jamaica"},
+	{"JO", "Jordan"},
+	{"JP", "Japan"},
+	{"KE", "Kenya"},
+	{"KG", "Kyrgyzstan"},
+	{"KH", "Cambodia"},
+	{"KI", "Kiribati"},
+	{"KM", "Comoros"},
+	{"KN", "St. Kitts & Nevis"},
+	{"KP", "North Korea"},
+	{"KR", "South Korea"},
+	{"KW", "Kuwait"},
+	{"KY", "Cayman Islands"},
+	{"KZ", "Kazakhstan"},
+	{"LA", "Laos"},
+	{"LB", "Lebanon"},
+	{"LC", "St. Lucia"},
+	{"LI", "Liechtenstein"},
+	{"LK", "Sri Lanka"},
+	{"LR", "Liberia"},
+	{"LS", "Lesotho"},
+	{"LT", "Lithuania"},
+	{"LU", "Luxembourg"},
+	{"LV", "Latvia"},
+	{"LY", "Libya"},
+	{"MA", "Morocco"},
+	{"MC", "Monaco"},
+	{"MD", "Moldova"},
+	{"ME", "Montenegro"},
+	{"MF", "St. Martin"},
+	{"MG", "Madagascar"},
+	{"MH", "Marshall Islands"},
+	{"MK", "North Macedonia"},
+	{"ML", "Mali"},
+	{"MM", "Myanmar (Burma)"},
+	{"MN", "Mongolia"},
+	{"MO", "Macao SAR China"},
+	{"MP", "Northern Mariana Islands"},
+	{"MQ", "Martinique"},
+	{"MR", "Mauritania"},
+	{"MS", "Montserrat"},
+	{"MT", "Malta"},
+	{"MU", "Mauritius"},
+	{"MV", "Maldives"},
+	{"MW", "Malawi"},
+	{"MX", "Mexico"},
+	{"MY", "Malaysia"},
+	{"MZ", "Mozambique"},
+	{"NA", "Namibia"},
+	{"NC", "New Caledonia"},
+	{"NE", "Niger"},
+	{"NF", "Norfolk Island"},
+	{"NG", "Nigeria"},
+	{"NI", "Nicaragua"},
+	{"NL", "Netherlands"},
+	{"NO", "Norway"},
+	{"NP", "Nepal"},
+	{"NR", "Nauru"},
+	{"NU", "Niue"},
+	{"NZ", "New Zealand"},
+	{"OM", "Oman"},
+	{"PA", "Panama"},
+	{"PE", "Peru"},
+	{"PF", "French Polynesia"},
+	{"PG", "Papua New Guinea"},
+	{"PH", "Philippines"},
+	{"PK", "Pakistan"},
+	{"PL", "Poland"},
+	{"PM", "St. Pierre & Miquelon"},
+	{"PN", "Pitcairn Islands"},
+	{"PR", "Puerto Rico"},
+	{"PS", "Palestinian Territories"},
+	{"PT", "Portugal"},
+	{"PW", "Palau"},
+	{"PY", "Paraguay"},
+	{"QA", "Qatar"},
+	{"RE", "Réunion"},
+	{"RO", "Romania"},
+	{"RS", "Serbia"},
+	{"RU", "Russia"},
+	{"RW", "Rwanda"},
+	{"SA", "Saudi Arabia"},
+	{"SB", "Solomon Islands"},
+	{"SC", "Seychelles"},
+	{"SD", "Sudan"},
+	{"SE", "Sweden"},
+	{"SG", "Singapore"},
+	{"SH", "St. Helena"},
+	{"SI", "Slovenia"},
+	{"SJ", "Svalbard & Jan Mayen"},
+	{"SK", "Slovakia"},
+	{"SL", "Sierra Leone"},
+	{"SM", "San Marino"},
+	{"SN", "Senegal"},
+	{"SO", "Somalia"},
+	{"SR", "Suriname"},
+	{"SS", "South Sudan"},
+	{"ST", "São Tomé & Príncipe"},
+	{"SV", "El Salvador"},
+	{"SX", "Sint Maarten"},
+	{"SY", "Syria"},
+	{"SZ", "Eswatini"},
+	{"TA", "Tristan da Cunha"},
+	{"TC", "Turks & Caicos Islands"},
+	{"TD", "Chad"},
+	{"TF", "French Southern Territories"},
+	{"TG", "Togo"},
+	{"TH", "Thailand"},
+	{"TJ", "Tajikistan"},
+	{"TK", "Tokelau"},
+	{"TL", "Timor-Leste"},
+	{"TM", "Turkmenistan"},
+	{"TN", "Tunisia"},
+	{"TO", "Tonga"},
+	{"TR", "Türkiye"},
+	{"TT", "Trinidad & Tobago"},
+	{"TV", "Tuvalu"},
+	{"TW", "Taiwan"},
+	{"TZ", "Tanzania"},
+	{"UA", "Ukraine"},
+	{"UG", "Uganda"},
+	{"UM", "U.S. Outlying Islands"},
+	{"UN", "United Nations"},
+	{"US", "United States"},
+	{"UY", "Uruguay"},
+	{"UZ", "Uzbekistan"},
+	{"VA", "Vatican City"},
+	{"VC", "St. Vincent & Grenadines"},
+	{"VE", "Venezuela"},
+	{"VG", "British Virgin Islands"},
+	{"VI", "U.S. Virgin Islands"},
+	{"VN", "Vietnam"},
+	{"VU", "Vanuatu"},
+	{"WF", "Wallis & Futuna"},
+	{"WS", "Samoa"},
+	{"XK", "Kosovo"},
+	{"YE", "Yemen"},
+	{"YT", "Mayotte"},
+	{"ZA", "South Africa"},
+	{"ZM", "Zambia"},
+	{"ZW", "Zimbabwe"},
+	{"GB-ENG", "England"},
+	{"GB-SCT", "Scotland"},
+	{"GB-WLS", "Wales"},
+}
+
+var countryByCode map[string]*country
+var countryByName map[string]*country
+var countryInitOnce = sync.Once{}
+
+func initCountries() {
+	countryInitOnce.Do(func() {
+		countryByCode = make(map[string]*country, len(countries))
+		countryByName = make(map[string]*country, len(countries))
+		for i := range countries {
+			c := &countries[i]
+			countryByCode[c.code] = c
+			countryByName[countryShortName(c.name)] = c
+		}
+	})
+}
+
+// lookupCountry returns the region or subdivision for a code such as "jp" or "GB-ENG".
+func lookupCountry(code string) (*country, bool) {
+	initCountries()
+	c, ok := countryByCode[strings.ToUpper(code)]
+	return c, ok
+}
+
+// lookupCountryName returns the region or subdivision for the shortcode form of its
+// name, such as "japan" or "united_states".
+func lookupCountryName(name string) (*country, bool) {
+	initCountries()
+	c, ok := countryByName[strings.ToLower(name)]
+	return c, ok
+}
+
+// countryShortName turns a country name into the form used in shortcodes:
+// "Côte d’Ivoire" becomes "cote_d_ivoire".
+func countryShortName(name string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	name, _, _ = transform.String(t, name)
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), "_")
+}
+
+// ErrUnknownCountry is returned for region codes that have no flag emoji.
+var ErrUnknownCountry = errors.New("emoji: unknown country")
+
+// Flag returns the flag emoji for an ISO 3166-1 alpha-2 region code such as "JP", or
+// for one of the subdivisions that have a flag, such as "GB-ENG". Codes are matched
+// in any case.
+func Flag(code string) (string, error) {
+	if flag, ok := flagSequence(code); ok {
+		return flag, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownCountry, code)
+}
+
+// FlagForLocale returns the flag emoji for the region of a BCP 47 or POSIX locale,
+// such as "ja-JP" or "pt_BR.UTF-8". For a locale without a region, such as "ja", the
+// most likely region is used.
+func FlagForLocale(locale string) (string, error) {
+	locale, _, _ = strings.Cut(locale, ".")
+	locale, _, _ = strings.Cut(locale, "@")
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return "", err
+	}
+	region, confidence := tag.Region()
+	if confidence == language.No {
+		return "", fmt.Errorf("%w: no region for locale %q", ErrUnknownCountry, locale)
+	}
+	return Flag(region.String())
+}
+
+// Country returns the region code, such as "JP", and the English name of a flag
+// emoji. It reports false if flag is not a single flag emoji.
+func Country(flag string) (code, name string, ok bool) {
+	code, size := scanFlag(flag)
+	if size == 0 || size != len(flag) {
+		return "", "", false
+	}
+	c, ok := lookupCountry(code)
+	if !ok {
+		return "", "", false
+	}
+	return c.code, c.name, true
+}
@@ -0,0 +1,84 @@
+package emoji
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFlagFunc(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{"JP", "\U0001f1ef\U0001f1f5"},
+		{"jp", "\U0001f1ef\U0001f1f5"},
+		{"GB-SCT", "\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f"},
+	}
+	for _, tt := range tests {
+		flag, err := Flag(tt.code)
+		if err != nil || flag != tt.expected {
+			t.Errorf("Flag(%q) = %q, %v, expected %q", tt.code, flag, err, tt.expected)
+		}
+	}
+	for _, code := range []string{"", "ZZ", "JPN", "GB-XYZ"} {
+		if _, err := Flag(code); !errors.Is(err, ErrUnknownCountry) {
+			t.Errorf("Flag(%q) error %v, expected ErrUnknownCountry", code, err)
+		}
+	}
+}
+
+func TestFlagForLocale(t *testing.T) {
+	tests := []struct {
+		locale   string
+		expected string
+	}{
+		{"ja-JP", "\U0001f1ef\U0001f1f5"},
+		{"pt_BR.UTF-8", "\U0001f1e7\U0001f1f7"},
+		{"de_AT@euro", "\U0001f1e6\U0001f1f9"},
+		{"ja", "\U0001f1ef\U0001f1f5"},
+	}
+	for _, tt := range tests {
+		flag, err := FlagForLocale(tt.locale)
+		if err != nil || flag != tt.expected {
+			t.Errorf("FlagForLocale(%q) = %q, %v, expected %q", tt.locale, flag, err, tt.expected)
+		}
+	}
+	if _, err := FlagForLocale("not a locale"); err == nil {
+		t.Error("FlagForLocale expected error")
+	}
+}
+
+func TestCountry(t *testing.T) {
+	code, name, ok := Country("\U0001f1ef\U0001f1f5")
+	if !ok || code != "JP" || name != "Japan" {
+		t.Errorf("Country = %q, %q, %v", code, name, ok)
+	}
+	code, name, ok = Country("\U0001f3f4\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f")
+	if !ok || code != "GB-WLS" || name != "Wales" {
+		t.Errorf("Country = %q, %q, %v", code, name, ok)
+	}
+	for _, flag := range []string{"", "\U0001f37a", "\U0001f1ef\U0001f1f5\U0001f1ef\U0001f1f5", "\U0001f1ff\U0001f1ff"} {
+		if _, _, ok := Country(flag); ok {
+			t.Errorf("Country(%q) ok", flag)
+		}
+	}
+}
+
+func TestCountryShortCodes(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{":flag_japan:", "\U0001f1ef\U0001f1f5"},
+		{":flag_united_states:", "\U0001f1fa\U0001f1f8"},
+		{":flag_cote_d_ivoire:", "\U0001f1e8\U0001f1ee"},
+		{":flag_south_georgia_south_sandwich_islands:", "\U0001f1ec\U0001f1f8"},
+		{":flag_scotland:", "\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f"},
+		{":flag_atlantis:", ":flag_atlantis:"},
+	}
+	for _, tt := range tests {
+		if actual := Emojize(tt.in); actual != tt.expected {
+			t.Errorf("Emojize(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package emoji
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// variationSelector16 requests the emoji presentation of the preceding character.
+const variationSelector16 = '\ufe0f'
+
+// emojiTrie is a rune trie over the keys of RevCodeMap used for longest-match scanning.
+type emojiTrie struct {
+	children  map[rune]*emojiTrie
+	shortCode string
+}
+
+func newEmojiTrie(revCodeMap map[string][]string) *emojiTrie {
+	root := &emojiTrie{}
+	for code, shortCodes := range revCodeMap {
+		if len(shortCodes) == 0 {
+			continue
+		}
+		root.insert(code, shortCodes[0])
+	}
+	return root
+}
+
+func (t *emojiTrie) insert(code, shortCode string) {
+	node := t
+	for _, r := range code {
+		child, ok := node.children[r]
+		if !ok {
+			if node.children == nil {
+				node.children = make(map[rune]*emojiTrie)
+			}
+			child = &emojiTrie{}
+			node.children[r] = child
+		}
+		node = child
+	}
+	node.shortCode = shortCode
+}
+
+// longestMatch returns the canonical shortcode of the longest emoji sequence at the
+// start of s and its length in bytes. A variation selector 16 that the data does not
+// list is treated as optional, so "❤️" and "❤" resolve the same way.
+func (t *emojiTrie) longestMatch(s string) (string, int) {
+	var shortCode string
+	var size int
+
+	node := t
+	for i := 0; i < len(s); {
+		r, n := utf8.DecodeRuneInString(s[i:])
+		child, ok := node.children[r]
+		switch {
+		case ok:
+			node = child
+		case r == variationSelector16 && node != t:
+			// skip the selector
+		default:
+			return shortCode, size
+		}
+		i += n
+		if node.shortCode != "" {
+			shortCode, size = node.shortCode, i
+		}
+	}
+	return shortCode, size
+}
+
+// longestTonedMatch matches an emoji sequence with a skin tone that has no shortcode
+// of its own and returns the shortcode of the sequence without the tone followed by
+// the Slack shortcode of the tone, as in ":farmer::skin-tone-4:".
+func (t *emojiTrie) longestTonedMatch(s string) (string, int) {
+	_, n := utf8.DecodeRuneInString(s)
+	modifier, size := utf8.DecodeRuneInString(s[n:])
+	tone := skinToneOf(modifier)
+	if tone == NoSkinTone {
+		return "", 0
+	}
+	untoned := s[:n] + s[n+size:]
+	shortCode, matched := t.longestMatch(untoned)
+	if matched < n {
+		return "", 0
+	}
+	return shortCode + slackSkinTone(tone), matched + size
+}
+
+// match returns the canonical shortcode of the emoji sequence at the start of s and
+// its length in bytes, trying sequences with shortcodes of their own, sequences with
+// a skin tone and flags, longest first. It returns 0 if s does not start with an
+// emoji that has a shortcode.
+func (t *emojiTrie) match(s string) (string, int) {
+	shortCode, n := t.longestMatch(s)
+	if tonedShortCode, tonedSize := t.longestTonedMatch(s); tonedSize > n {
+		shortCode, n = tonedShortCode, tonedSize
+	}
+	if code, flagSize := scanFlag(s); flagSize > n {
+		shortCode, n = ":flag-"+code+":", flagSize
+	}
+	return shortCode, n
+}
+
+// Demojize replaces every emoji sequence in the string with its canonical shortcode
+// (see NormalizeShortCode). Sequences are matched longest first, so ZWJ sequences,
+// keycaps, skin tones and flags come back as a single shortcode. A sequence with a
+// skin tone that has no shortcode of its own comes back as the shortcode without the
+// tone followed by the tone, as in ":farmer::skin-tone-4:". Flags without a
+// shortcode of their own come back as ":flag-xx:" or ":flag-xx-yyy:".
+func Demojize(s string) string {
+	trie := currentTables().sequenceTrie()
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i := 0; i < len(s); {
+		shortCode, n := trie.match(s[i:])
+		if n > 0 {
+			sb.WriteString(shortCode)
+			i += n
+			continue
+		}
+		_, n = utf8.DecodeRuneInString(s[i:])
+		sb.WriteString(s[i : i+n])
+		i += n
+	}
+	return sb.String()
+}
@@ -0,0 +1,36 @@
+package emoji
+
+import (
+	"testing"
+)
+
+func TestDemojize(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"", ""},
+		{"no emoji here", "no emoji here"},
+		{"\U0001f37a ビール!!!", ":beer: ビール!!!"},
+		{"\U0001f44d", ":+1:"},
+		{"\U0001f44d\U0001f3fd", ":thumbsup_tone3:"},
+		{"\U0001f1fa\U0001f1f8", ":us:"},
+		{"1\ufe0f\u20e3 and 1", ":one: and 1"},
+		{"\u263a\ufe0f \u263a", ":relaxed: :smiling_face:"},
+		{"\U0001f468\u200d\U0001f469\u200d\U0001f467", NormalizeShortCode(":family_man_woman_girl:")},
+		{"\U0001f3f4\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", ":england:"},
+	}
+	for _, tt := range tests {
+		if actual := Demojize(tt.in); actual != tt.expected {
+			t.Errorf("Demojize(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+}
+
+func TestDemojizeRoundTrip(t *testing.T) {
+	for code, shortCodes := range RevCodeMap() {
+		if actual := Demojize(code); actual != shortCodes[0] {
+			t.Errorf("Demojize(%q) = %q, expected %q", code, actual, shortCodes[0])
+		}
+	}
+}
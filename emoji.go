@@ -3,32 +3,41 @@ package emoji
 
 import (
 	"bytes"
-	"errors"
-	"fmt"
 	"io"
-	"regexp"
+	"sync"
 	"unicode"
+	"unicode/utf8"
 )
 
-//go:generate generateEmojiCodeMap -pkg emoji -o emoji_codemap.go
+//go:generate generateEmojiCodeMap -pkg emoji -o emoji_codemap.go -meta emoji_metadata.go -props emoji_properties.go
 
 // Replace Padding character for emoji.
+// It applies to the package-level functions; use NewReplacer with WithPadding for
+// padding that does not affect other users of the package.
 var (
 	ReplacePadding = " "
 )
 
-// CodeMap gets the underlying map of emoji.
+// CodeMap gets the underlying map of emoji, including shortcodes added by Register.
+// The map must not be modified.
 func CodeMap() map[string]string {
-	return emojiCode()
+	return currentTables().codeMap
 }
 
-// RevCodeMap gets the underlying map of emoji.
+// RevCodeMap gets the underlying map of emoji, including shortcodes added by Register.
+// The map must not be modified.
 func RevCodeMap() map[string][]string {
-	return emojiRevCode()
+	return currentTables().revCodeMap
 }
 
+// AliasList returns all shortcodes of the emoji of `shortCode`. The shortcodes of an
+// image emoji are those registered with the same URL.
 func AliasList(shortCode string) []string {
-	return emojiRevCode()[emojiCode()[shortCode]]
+	t := currentTables()
+	if img, ok := t.images[shortCode]; ok {
+		return t.imageAliases[img.URL]
+	}
+	return t.revCodeMap[t.codeMap[shortCode]]
 }
 
 // HasAlias flags if the given `shortCode` has multiple aliases with other
@@ -46,19 +55,9 @@ func NormalizeShortCode(shortCode string) string {
 	return shortLists[0]
 }
 
-// regular expression that matches :flag-[countrycode]:
-var flagRegexp = regexp.MustCompile(":flag-([a-z]{2}):")
-
 // Emojize Converts the string passed as an argument to a emoji. For unsupported emoji, the string passed as an argument is returned as is.
 func Emojize(x string) string {
-	str, ok := emojiCode()[x]
-	if ok {
-		return str + ReplacePadding
-	}
-	if match := flagRegexp.FindStringSubmatch(x); len(match) == 2 {
-		return regionalIndicator(match[1][0]) + regionalIndicator(match[1][1])
-	}
-	return x
+	return defaultReplacer.Emojize(x)
 }
 
 // regionalIndicator maps a lowercase letter to a unicode regional indicator
@@ -66,93 +65,337 @@ func regionalIndicator(i byte) string {
 	return string('\U0001F1E6' + rune(i) - 'a')
 }
 
-func replaceEmoji(input *bytes.Buffer) string {
-	emoji := bytes.NewBufferString(":")
-	for {
-		i, _, err := input.ReadRune()
-		if err != nil {
-			// not replace
-			return emoji.String()
+var maxShortCodeLen int
+var maxShortCodeLenInitOnce = sync.Once{}
+
+// shortCodeMaxLen returns the length in bytes of the longest built-in shortcode.
+func shortCodeMaxLen() int {
+	maxShortCodeLenInitOnce.Do(func() {
+		maxShortCodeLen = codeMapMaxLen(emojiCode())
+	})
+	return maxShortCodeLen
+}
+
+// codeMapMaxLen returns the length in bytes of the longest shortcode Emojize can
+// replace with the given code map.
+func codeMapMaxLen(codeMap map[string]string) int {
+	maxLen := flagShortCodeMaxLen()
+	for shortCode := range codeMap {
+		if len(shortCode) > maxLen {
+			maxLen = len(shortCode)
 		}
+	}
+	return maxLen
+}
+
+// scanner replaces shortcodes in input that may arrive in several pieces.
+type scanner struct {
+	r *Replacer
+	// skipping is set inside a candidate that grew too long to be a shortcode.
+	skipping bool
+	// escape makes the scanner escape shortcodes instead of replacing them.
+	escape bool
+	// html makes the scanner render emoji as HTML and escape the text around them.
+	html bool
+	// strip makes the scanner remove shortcodes, recording where in removed.
+	strip   bool
+	removed []int
+
+	// prev is the last rune read.
+	prev rune
+	// literalToken is set inside a URL or IPv6 address, which is copied as is up to
+	// the next whitespace.
+	literalToken bool
+	// tokenNotHex and tokenDigit describe the text read since the last whitespace,
+	// to tell IPv6 addresses apart from words like "std::vector".
+	tokenNotHex, tokenDigit bool
+
+	// unknown, if set, is called with the candidates that are not shortcodes.
+	unknown func(candidate string, offset int)
+	// known, if set, is called with the shortcodes that are replaced, and the offset
+	// and size of their text in the input.
+	known func(shortCode string, offset, size int)
+}
 
-		if i == ':' && emoji.Len() == 1 {
-			return emoji.String() + replaceEmoji(input)
+// scan appends the emojized form of src to dst. Unless atEOF is set, it stops in
+// front of input it cannot decide on yet (an unterminated candidate or an incomplete
+// rune) and reports how many bytes of src were consumed, so the rest can be passed
+// again together with the next piece.
+func (s *scanner) scan(dst, src []byte, atEOF bool) ([]byte, int) {
+	i := 0
+	for i < len(src) {
+		if !atEOF && !utf8.FullRune(src[i:]) {
+			break
+		}
+		r, n := utf8.DecodeRune(src[i:])
+
+		if s.literalToken || s.skipping && r != '\\' {
+			dst = s.appendText(dst, r)
+			i += n
+			s.skipping = s.skipping && !(r == ':' || unicode.IsSpace(r))
+			s.see(r)
+			continue
+		}
+		s.skipping = false
+
+		if r == '\\' {
+			if i+n == len(src) && !atEOF {
+				break
+			}
+			if i+n < len(src) && src[i+n] == ':' {
+				candidate, size, ok := s.scanCandidate(src[i+n:], atEOF)
+				if !ok {
+					break
+				}
+				next, ok := peekRune(src[i+n+size:], atEOF)
+				if !ok {
+					s.skipping = false
+					break
+				}
+				if _, _, found := s.r.lookup(candidate); found && s.context(next) {
+					// "\:beer:" is the literal text ":beer:"
+					if s.escape {
+						dst = append(dst, '\\', '\\')
+					}
+					dst = s.appendTextString(dst, candidate)
+					s.see('\\')
+					s.seeString(candidate)
+					i += n + size
+					continue
+				}
+				// not an escape, scan the candidate again from its colon
+				s.skipping = false
+			}
+			dst = s.appendText(dst, '\\')
+			s.see('\\')
+			i += n
+			continue
+		}
+
+		if r != ':' {
+			dst = s.appendText(dst, r)
+			s.see(r)
+			i += n
+			continue
+		}
+
+		if s.r.contextRules() && isASCIIAlnum(s.prev) {
+			// a colon inside a word, a time or a URL does not start a shortcode
+			if len(src)-i < 3 && !atEOF {
+				break
+			}
+			rest := src[i+1:]
+			if bytes.HasPrefix(rest, []byte("//")) || bytes.HasPrefix(rest, []byte(":")) && !s.tokenNotHex && s.tokenDigit {
+				// a URL or an IPv6 address
+				s.literalToken = true
+			}
+			dst = s.appendText(dst, r)
+			s.see(r)
+			i += n
+			continue
 		}
 
-		emoji.WriteRune(i)
-		switch {
-		case unicode.IsSpace(i):
-			return emoji.String()
-		case i == ':':
-			return Emojize(emoji.String())
+		candidate, size, ok := s.scanCandidate(src[i:], atEOF)
+		if !ok {
+			break
+		}
+		next := EndOfInput
+		if len(candidate) > 1 && candidate[len(candidate)-1] == ':' {
+			if code, found := s.r.codes()[candidate]; found && !s.escape && takesSkinTone(code) {
+				// ":+1::skin-tone-4:" is the same as ":+1_tone3:"
+				tone, n, ok := scanSkinTone(src[i+size:], atEOF)
+				if !ok {
+					break
+				}
+				if tone != NoSkinTone {
+					candidate = toneShortCode(candidate, tone)
+					size += n
+				}
+			}
+			// the padding after an emoji may depend on what follows
+			if next, ok = peekRune(src[i+size:], atEOF); !ok {
+				break
+			}
+		}
+		if s.context(next) {
+			if s.unknown != nil && len(candidate) > 1 {
+				if _, _, found := s.r.lookup(candidate); !found {
+					s.unknown(candidate, i)
+				}
+			}
+			if s.known != nil {
+				if _, _, found := s.r.lookup(candidate); found {
+					s.known(candidate, i, size)
+				}
+			}
+			dst = s.appendCandidate(dst, candidate, next)
+		} else {
+			dst = s.appendTextString(dst, string(src[i:i+size]))
 		}
+		s.seeString(string(src[i : i+size]))
+		i += size
 	}
+	return dst, i
 }
 
-func compile(x string) string {
-	if x == "" {
-		return ""
+// context reports whether a shortcode followed by next may be replaced.
+func (s *scanner) context(next rune) bool {
+	return !s.r.contextRules() || !isASCIIAlnum(next)
+}
+
+// see records a rune read for the context of the following shortcodes.
+func (s *scanner) see(r rune) {
+	s.prev = r
+	switch {
+	case unicode.IsSpace(r):
+		s.literalToken, s.tokenNotHex, s.tokenDigit = false, false, false
+	case r >= '0' && r <= '9':
+		s.tokenDigit = true
+	case r >= 'a' && r <= 'f', r >= 'A' && r <= 'F', r == ':', r == '.':
+	default:
+		s.tokenNotHex = true
+	}
+}
+
+func (s *scanner) seeString(text string) {
+	for _, r := range text {
+		s.see(r)
+	}
+}
+
+// isASCIIAlnum reports whether r is an ASCII letter or digit. Other letters do not
+// count, as scripts like Japanese are written without spaces between words.
+func isASCIIAlnum(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
+// appendCandidate appends a candidate followed by next in the input, replaced if it
+// is a shortcode.
+func (s *scanner) appendCandidate(dst []byte, candidate string, next rune) []byte {
+	code, padded, ok := s.r.lookup(candidate)
+	switch {
+	case !ok:
+		// a colon followed by another colon or a backslash is literal as well
+		return s.appendTextString(dst, candidate)
+	case s.strip:
+		s.removed = append(s.removed, len(dst))
+		return dst
+	case s.escape:
+		dst = append(dst, '\\')
+		return append(dst, candidate...)
+	case s.html:
+		if img, ok := s.r.images()[candidate]; ok {
+			return s.r.appendHTML(dst, HTMLEmoji{Char: code, ShortCode: candidate, URL: img.URL})
+		}
+		return s.r.appendHTML(dst, HTMLEmoji{Char: code, ShortCode: candidate})
+	case padded:
+		dst = append(dst, code...)
+		return append(dst, s.r.padding.Padding(code, next)...)
+	}
+	return append(dst, code...)
+}
+
+// appendText appends a rune of the text around shortcodes.
+func (s *scanner) appendText(dst []byte, r rune) []byte {
+	if s.html {
+		return appendEscapedHTML(dst, r)
+	}
+	return utf8.AppendRune(dst, r)
+}
+
+func (s *scanner) appendTextString(dst []byte, text string) []byte {
+	for _, r := range text {
+		dst = s.appendText(dst, r)
 	}
+	return dst
+}
 
-	input := bytes.NewBufferString(x)
-	output := bytes.NewBufferString("")
+// peekRune returns the first rune of src, or EndOfInput if src is empty. It returns
+// false if more input is needed to decide.
+func peekRune(src []byte, atEOF bool) (rune, bool) {
+	if len(src) == 0 {
+		return EndOfInput, atEOF
+	}
+	if !atEOF && !utf8.FullRune(src) {
+		return 0, false
+	}
+	r, _ := utf8.DecodeRune(src)
+	return r, true
+}
 
-	for {
-		i, _, err := input.ReadRune()
-		if err != nil {
+// scanCandidate reads a shortcode candidate from src, which starts with a colon. The
+// candidate ends with the next colon, whitespace or the end of input, or in front of a
+// backslash. It returns false if more input is needed to decide.
+func (s *scanner) scanCandidate(src []byte, atEOF bool) (string, int, bool) {
+	buf := []byte{':'}
+	i := 1
+	for i < len(src) {
+		if !atEOF && !utf8.FullRune(src[i:]) {
 			break
 		}
-		switch i {
-		default:
-			output.WriteRune(i)
-		case ':':
-			output.WriteString(replaceEmoji(input))
+		r, n := utf8.DecodeRune(src[i:])
+		if r == ':' && i == 1 || r == '\\' {
+			// leave the colon or an escape to start the next candidate
+			return string(buf), i, true
 		}
+		buf = utf8.AppendRune(buf, r)
+		i += n
+		if r == ':' || unicode.IsSpace(r) {
+			return string(buf), i, true
+		}
+	}
+	if atEOF {
+		return string(buf), i, true
+	}
+	if len(buf) >= s.r.shortCodeMaxLen() {
+		// too long to match, emit what we have and pass the rest through
+		s.skipping = true
+		return string(buf), i, true
 	}
-	return output.String()
+	return "", 0, false
 }
 
 // Print is fmt.Print which supports emoji
 func Print(a ...interface{}) (int, error) {
-	return fmt.Print(compile(fmt.Sprint(a...)))
+	return defaultReplacer.Print(a...)
 }
 
 // Println is fmt.Println which supports emoji
 func Println(a ...interface{}) (int, error) {
-	return fmt.Println(compile(fmt.Sprint(a...)))
+	return defaultReplacer.Println(a...)
 }
 
 // Printf is fmt.Printf which supports emoji
 func Printf(format string, a ...interface{}) (int, error) {
-	return fmt.Print(compile(fmt.Sprintf(format, a...)))
+	return defaultReplacer.Printf(format, a...)
 }
 
 // Fprint is fmt.Fprint which supports emoji
 func Fprint(w io.Writer, a ...interface{}) (int, error) {
-	return fmt.Fprint(w, compile(fmt.Sprint(a...)))
+	return defaultReplacer.Fprint(w, a...)
 }
 
 // Fprintln is fmt.Fprintln which supports emoji
 func Fprintln(w io.Writer, a ...interface{}) (int, error) {
-	return fmt.Fprintln(w, compile(fmt.Sprint(a...)))
+	return defaultReplacer.Fprintln(w, a...)
 }
 
 // Fprintf is fmt.Fprintf which supports emoji
 func Fprintf(w io.Writer, format string, a ...interface{}) (int, error) {
-	return fmt.Fprint(w, compile(fmt.Sprintf(format, a...)))
+	return defaultReplacer.Fprintf(w, format, a...)
 }
 
 // Sprint is fmt.Sprint which supports emoji
 func Sprint(a ...interface{}) string {
-	return compile(fmt.Sprint(a...))
+	return defaultReplacer.Sprint(a...)
 }
 
 // Sprintf is fmt.Sprintf which supports emoji
 func Sprintf(format string, a ...interface{}) string {
-	return compile(fmt.Sprintf(format, a...))
+	return defaultReplacer.Sprintf(format, a...)
 }
 
 // Errorf is fmt.Errorf which supports emoji
 func Errorf(format string, a ...interface{}) error {
-	return errors.New(compile(Sprintf(format, a...)))
+	return defaultReplacer.Errorf(format, a...)
 }
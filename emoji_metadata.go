@@ -0,0 +1,3802 @@
+package emoji
+
+import (
+	"sync"
+)
+
+// NOTE: THIS FILE WAS PRODUCED BY THE
+// EMOJICODEMAP CODE GENERATION TOOL (github.com/kyokomi/emoji/cmd/generateEmojiCodeMap)
+// DO NOT EDIT
+
+var emojiDataList []emojiData
+var emojiDataListInitOnce = sync.Once{}
+
+func emojiDataTable() []emojiData {
+	emojiDataListInitOnce.Do(func() {
+		emojiDataList = []emojiData{
+			{"\U0001f600", "grinning face", "Smileys & Emotion", "face-smiling", []string{"face", "smiling"}, "1.0", false},
+			{"\U0001f603", "grinning face with big eyes", "Smileys & Emotion", "face-smiling", []string{"face", "smiling"}, "0.6", false},
+			{"\U0001f604", "grinning face with smiling eyes", "Smileys & Emotion", "face-smiling", []string{"face", "smiling"}, "0.6", false},
+			{"\U0001f601", "beaming face with smiling eyes", "Smileys & Emotion", "face-smiling", []string{"face", "smiling"}, "0.6", false},
+			{"\U0001f606", "grinning squinting face", "Smileys & Emotion", "face-smiling", []string{"face", "smiling"}, "0.6", false},
+			{"\U0001f605", "grinning face with sweat", "Smileys & Emotion", "face-smiling", []string{"face", "smiling"}, "0.6", false},
+			{"\U0001f923", "rolling on the floor laughing", "Smileys & Emotion", "face-smiling", []string{"face", "smiling"}, "3.0", false},
+			{"\U0001f602", "face with tears of joy", "Smileys & Emotion", "face-smiling", []string{"face", "smiling"}, "0.6", false},
+			{"\U0001f642", "slightly smiling face", "Smileys & Emotion", "face-smiling", []string{"face", "smiling"}, "1.0", false},
+			{"\U0001f643", "upside-down face", "Smileys & Emotion", "face-smiling", []string{"face", "smiling"}, "1.0", false},
+			{"\U0001fae0", "melting face", "Smileys & Emotion", "face-smiling", []string{"face", "smiling"}, "14.0", false},
+			{"\U0001f609", "winking face", "Smileys & Emotion", "face-smiling", []string{"face", "smiling"}, "0.6", false},
+			{"\U0001f60a", "smiling face with smiling eyes", "Smileys & Emotion", "face-smiling", []string{"face", "smiling"}, "0.6", false},
+			{"\U0001f607", "smiling face with halo", "Smileys & Emotion", "face-smiling", []string{"face", "smiling"}, "1.0", false},
+			{"\U0001f970", "smiling face with hearts", "Smileys & Emotion", "face-affection", []string{"affection", "face"}, "11.0", false},
+			{"\U0001f60d", "smiling face with heart-eyes", "Smileys & Emotion", "face-affection", []string{"affection", "face"}, "0.6", false},
+			{"\U0001f929", "star-struck", "Smileys & Emotion", "face-affection", []string{"affection", "face"}, "5.0", false},
+			{"\U0001f618", "face blowing a kiss", "Smileys & Emotion", "face-affection", []string{"affection", "face"}, "0.6", false},
+			{"\U0001f617", "kissing face", "Smileys & Emotion", "face-affection", []string{"affection", "face"}, "1.0", false},
+			{"\u263a\ufe0f", "smiling face", "Smileys & Emotion", "face-affection", []string{"affection", "face"}, "0.6", false},
+			{"\U0001f61a", "kissing face with closed eyes", "Smileys & Emotion", "face-affection", []string{"affection", "face"}, "0.6", false},
+			{"\U0001f619", "kissing face with smiling eyes", "Smileys & Emotion", "face-affection", []string{"affection", "face"}, "1.0", false},
+			{"\U0001f972", "smiling face with tear", "Smileys & Emotion", "face-affection", []string{"affection", "face"}, "13.0", false},
+			{"\U0001f60b", "face savoring food", "Smileys & Emotion", "face-tongue", []string{"face", "tongue"}, "0.6", false},
+			{"\U0001f61b", "face with tongue", "Smileys & Emotion", "face-tongue", []string{"face", "tongue"}, "1.0", false},
+			{"\U0001f61c", "winking face with tongue", "Smileys & Emotion", "face-tongue", []string{"face", "tongue"}, "0.6", false},
+			{"\U0001f92a", "zany face", "Smileys & Emotion", "face-tongue", []string{"face", "tongue"}, "5.0", false},
+			{"\U0001f61d", "squinting face with tongue", "Smileys & Emotion", "face-tongue", []string{"face", "tongue"}, "0.6", false},
+			{"\U0001f911", "money-mouth face", "Smileys & Emotion", "face-tongue", []string{"face", "tongue"}, "1.0", false},
+			{"\U0001f917", "smiling face with open hands", "Smileys & Emotion", "face-hand", []string{"face", "hand"}, "1.0", false},
+			{"\U0001f92d", "face with hand over mouth", "Smileys & Emotion", "face-hand", []string{"face", "hand"}, "5.0", false},
+			{"\U0001fae2", "face with open eyes and hand over mouth", "Smileys & Emotion", "face-hand", []string{"face", "hand"}, "14.0", false},
+			{"\U0001fae3", "face with peeking eye", "Smileys & Emotion", "face-hand", []string{"face", "hand"}, "14.0", false},
+			{"\U0001f92b", "shushing face", "Smileys & Emotion", "face-hand", []string{"face", "hand"}, "5.0", false},
+			{"\U0001f914", "thinking face", "Smileys & Emotion", "face-hand", []string{"face", "hand"}, "1.0", false},
+			{"\U0001fae1", "saluting face", "Smileys & Emotion", "face-hand", []string{"face", "hand"}, "14.0", false},
+			{"\U0001f910", "zipper-mouth face", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "1.0", false},
+			{"\U0001f928", "face with raised eyebrow", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "5.0", false},
+			{"\U0001f610", "neutral face", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "0.7", false},
+			{"\U0001f611", "expressionless face", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "1.0", false},
+			{"\U0001f636", "face without mouth", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "1.0", false},
+			{"\U0001fae5", "dotted line face", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "14.0", false},
+			{"\U0001f636\u200d\U0001f32b\ufe0f", "face in clouds", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "13.1", false},
+			{"\U0001f60f", "smirking face", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "0.6", false},
+			{"\U0001f612", "unamused face", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "0.6", false},
+			{"\U0001f644", "face with rolling eyes", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "1.0", false},
+			{"\U0001f62c", "grimacing face", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "1.0", false},
+			{"\U0001f62e\u200d\U0001f4a8", "face exhaling", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "13.1", false},
+			{"\U0001f925", "lying face", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "3.0", false},
+			{"\U0001fae8", "shaking face", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "15.0", false},
+			{"\U0001f642\u200d\u2194\ufe0f", "head shaking horizontally", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "15.1", false},
+			{"\U0001f642\u200d\u2195\ufe0f", "head shaking vertically", "Smileys & Emotion", "face-neutral-skeptical", []string{"face", "neutral", "skeptical"}, "15.1", false},
+			{"\U0001f60c", "relieved face", "Smileys & Emotion", "face-sleepy", []string{"face", "sleepy"}, "0.6", false},
+			{"\U0001f614", "pensive face", "Smileys & Emotion", "face-sleepy", []string{"face", "sleepy"}, "0.6", false},
+			{"\U0001f62a", "sleepy face", "Smileys & Emotion", "face-sleepy", []string{"face", "sleepy"}, "0.6", false},
+			{"\U0001f924", "drooling face", "Smileys & Emotion", "face-sleepy", []string{"face", "sleepy"}, "3.0", false},
+			{"\U0001f634", "sleeping face", "Smileys & Emotion", "face-sleepy", []string{"face", "sleepy"}, "1.0", false},
+			{"\U0001f637", "face with medical mask", "Smileys & Emotion", "face-unwell", []string{"face", "unwell"}, "0.6", false},
+			{"\U0001f912", "face with thermometer", "Smileys & Emotion", "face-unwell", []string{"face", "unwell"}, "1.0", false},
+			{"\U0001f915", "face with head-bandage", "Smileys & Emotion", "face-unwell", []string{"face", "unwell"}, "1.0", false},
+			{"\U0001f922", "nauseated face", "Smileys & Emotion", "face-unwell", []string{"face", "unwell"}, "3.0", false},
+			{"\U0001f92e", "face vomiting", "Smileys & Emotion", "face-unwell", []string{"face", "unwell"}, "5.0", false},
+			{"\U0001f927", "sneezing face", "Smileys & Emotion", "face-unwell", []string{"face", "unwell"}, "3.0", false},
+			{"\U0001f975", "hot face", "Smileys & Emotion", "face-unwell", []string{"face", "unwell"}, "11.0", false},
+			{"\U0001f976", "cold face", "Smileys & Emotion", "face-unwell", []string{"face", "unwell"}, "11.0", false},
+			{"\U0001f974", "woozy face", "Smileys & Emotion", "face-unwell", []string{"face", "unwell"}, "11.0", false},
+			{"\U0001f635", "face with crossed-out eyes", "Smileys & Emotion", "face-unwell", []string{"face", "unwell"}, "0.6", false},
+			{"\U0001f635\u200d\U0001f4ab", "face with spiral eyes", "Smileys & Emotion", "face-unwell", []string{"face", "unwell"}, "13.1", false},
+			{"\U0001f92f", "exploding head", "Smileys & Emotion", "face-unwell", []string{"face", "unwell"}, "5.0", false},
+			{"\U0001f920", "cowboy hat face", "Smileys & Emotion", "face-hat", []string{"face", "hat"}, "3.0", false},
+			{"\U0001f973", "partying face", "Smileys & Emotion", "face-hat", []string{"face", "hat"}, "11.0", false},
+			{"\U0001f978", "disguised face", "Smileys & Emotion", "face-hat", []string{"face", "hat"}, "13.0", false},
+			{"\U0001f60e", "smiling face with sunglasses", "Smileys & Emotion", "face-glasses", []string{"face", "glasses"}, "1.0", false},
+			{"\U0001f913", "nerd face", "Smileys & Emotion", "face-glasses", []string{"face", "glasses"}, "1.0", false},
+			{"\U0001f9d0", "face with monocle", "Smileys & Emotion", "face-glasses", []string{"face", "glasses"}, "5.0", false},
+			{"\U0001f615", "confused face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "1.0", false},
+			{"\U0001fae4", "face with diagonal mouth", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "14.0", false},
+			{"\U0001f61f", "worried face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "1.0", false},
+			{"\U0001f641", "slightly frowning face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "1.0", false},
+			{"\u2639\ufe0f", "frowning face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.7", false},
+			{"\U0001f62e", "face with open mouth", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "1.0", false},
+			{"\U0001f62f", "hushed face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "1.0", false},
+			{"\U0001f632", "astonished face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.6", false},
+			{"\U0001f633", "flushed face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.6", false},
+			{"\U0001f97a", "pleading face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "11.0", false},
+			{"\U0001f979", "face holding back tears", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "14.0", false},
+			{"\U0001f626", "frowning face with open mouth", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "1.0", false},
+			{"\U0001f627", "anguished face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "1.0", false},
+			{"\U0001f628", "fearful face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.6", false},
+			{"\U0001f630", "anxious face with sweat", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.6", false},
+			{"\U0001f625", "sad but relieved face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.6", false},
+			{"\U0001f622", "crying face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.6", false},
+			{"\U0001f62d", "loudly crying face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.6", false},
+			{"\U0001f631", "face screaming in fear", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.6", false},
+			{"\U0001f616", "confounded face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.6", false},
+			{"\U0001f623", "persevering face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.6", false},
+			{"\U0001f61e", "disappointed face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.6", false},
+			{"\U0001f613", "downcast face with sweat", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.6", false},
+			{"\U0001f629", "weary face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.6", false},
+			{"\U0001f62b", "tired face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "0.6", false},
+			{"\U0001f971", "yawning face", "Smileys & Emotion", "face-concerned", []string{"concerned", "face"}, "12.0", false},
+			{"\U0001f624", "face with steam from nose", "Smileys & Emotion", "face-negative", []string{"face", "negative"}, "0.6", false},
+			{"\U0001f621", "enraged face", "Smileys & Emotion", "face-negative", []string{"face", "negative"}, "0.6", false},
+			{"\U0001f620", "angry face", "Smileys & Emotion", "face-negative", []string{"face", "negative"}, "0.6", false},
+			{"\U0001f92c", "face with symbols on mouth", "Smileys & Emotion", "face-negative", []string{"face", "negative"}, "5.0", false},
+			{"\U0001f608", "smiling face with horns", "Smileys & Emotion", "face-negative", []string{"face", "negative"}, "1.0", false},
+			{"\U0001f47f", "angry face with horns", "Smileys & Emotion", "face-negative", []string{"face", "negative"}, "0.6", false},
+			{"\U0001f480", "skull", "Smileys & Emotion", "face-negative", []string{"face", "negative"}, "0.6", false},
+			{"\u2620\ufe0f", "skull and crossbones", "Smileys & Emotion", "face-negative", []string{"face", "negative"}, "1.0", false},
+			{"\U0001f4a9", "pile of poo", "Smileys & Emotion", "face-costume", []string{"costume", "face"}, "0.6", false},
+			{"\U0001f921", "clown face", "Smileys & Emotion", "face-costume", []string{"costume", "face"}, "3.0", false},
+			{"\U0001f479", "ogre", "Smileys & Emotion", "face-costume", []string{"costume", "face"}, "0.6", false},
+			{"\U0001f47a", "goblin", "Smileys & Emotion", "face-costume", []string{"costume", "face"}, "0.6", false},
+			{"\U0001f47b", "ghost", "Smileys & Emotion", "face-costume", []string{"costume", "face"}, "0.6", false},
+			{"\U0001f47d", "alien", "Smileys & Emotion", "face-costume", []string{"costume", "face"}, "0.6", false},
+			{"\U0001f47e", "alien monster", "Smileys & Emotion", "face-costume", []string{"costume", "face"}, "0.6", false},
+			{"\U0001f916", "robot", "Smileys & Emotion", "face-costume", []string{"costume", "face"}, "1.0", false},
+			{"\U0001f63a", "grinning cat", "Smileys & Emotion", "cat-face", []string{"cat", "face"}, "0.6", false},
+			{"\U0001f638", "grinning cat with smiling eyes", "Smileys & Emotion", "cat-face", []string{"cat", "face"}, "0.6", false},
+			{"\U0001f639", "cat with tears of joy", "Smileys & Emotion", "cat-face", []string{"cat", "face"}, "0.6", false},
+			{"\U0001f63b", "smiling cat with heart-eyes", "Smileys & Emotion", "cat-face", []string{"cat", "face"}, "0.6", false},
+			{"\U0001f63c", "cat with wry smile", "Smileys & Emotion", "cat-face", []string{"cat", "face"}, "0.6", false},
+			{"\U0001f63d", "kissing cat", "Smileys & Emotion", "cat-face", []string{"cat", "face"}, "0.6", false},
+			{"\U0001f640", "weary cat", "Smileys & Emotion", "cat-face", []string{"cat", "face"}, "0.6", false},
+			{"\U0001f63f", "crying cat", "Smileys & Emotion", "cat-face", []string{"cat", "face"}, "0.6", false},
+			{"\U0001f63e", "pouting cat", "Smileys & Emotion", "cat-face", []string{"cat", "face"}, "0.6", false},
+			{"\U0001f648", "see-no-evil monkey", "Smileys & Emotion", "monkey-face", []string{"face", "monkey"}, "0.6", false},
+			{"\U0001f649", "hear-no-evil monkey", "Smileys & Emotion", "monkey-face", []string{"face", "monkey"}, "0.6", false},
+			{"\U0001f64a", "speak-no-evil monkey", "Smileys & Emotion", "monkey-face", []string{"face", "monkey"}, "0.6", false},
+			{"\U0001f48c", "love letter", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\U0001f498", "heart with arrow", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\U0001f49d", "heart with ribbon", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\U0001f496", "sparkling heart", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\U0001f497", "growing heart", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\U0001f493", "beating heart", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\U0001f49e", "revolving hearts", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\U0001f495", "two hearts", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\U0001f49f", "heart decoration", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\u2763\ufe0f", "heart exclamation", "Smileys & Emotion", "heart", []string{"heart"}, "1.0", false},
+			{"\U0001f494", "broken heart", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\u2764\ufe0f\u200d\U0001f525", "heart on fire", "Smileys & Emotion", "heart", []string{"heart"}, "13.1", false},
+			{"\u2764\ufe0f\u200d\U0001fa79", "mending heart", "Smileys & Emotion", "heart", []string{"heart"}, "13.1", false},
+			{"\u2764\ufe0f", "red heart", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\U0001fa77", "pink heart", "Smileys & Emotion", "heart", []string{"heart"}, "15.0", false},
+			{"\U0001f9e1", "orange heart", "Smileys & Emotion", "heart", []string{"heart"}, "5.0", false},
+			{"\U0001f49b", "yellow heart", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\U0001f49a", "green heart", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\U0001f499", "blue heart", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\U0001fa75", "light blue heart", "Smileys & Emotion", "heart", []string{"heart"}, "15.0", false},
+			{"\U0001f49c", "purple heart", "Smileys & Emotion", "heart", []string{"heart"}, "0.6", false},
+			{"\U0001f90e", "brown heart", "Smileys & Emotion", "heart", []string{"heart"}, "12.0", false},
+			{"\U0001f5a4", "black heart", "Smileys & Emotion", "heart", []string{"heart"}, "3.0", false},
+			{"\U0001fa76", "grey heart", "Smileys & Emotion", "heart", []string{"heart"}, "15.0", false},
+			{"\U0001f90d", "white heart", "Smileys & Emotion", "heart", []string{"heart"}, "12.0", false},
+			{"\U0001f48b", "kiss mark", "Smileys & Emotion", "emotion", []string{"emotion"}, "0.6", false},
+			{"\U0001f4af", "hundred points", "Smileys & Emotion", "emotion", []string{"emotion"}, "0.6", false},
+			{"\U0001f4a2", "anger symbol", "Smileys & Emotion", "emotion", []string{"emotion"}, "0.6", false},
+			{"\U0001f4a5", "collision", "Smileys & Emotion", "emotion", []string{"emotion"}, "0.6", false},
+			{"\U0001f4ab", "dizzy", "Smileys & Emotion", "emotion", []string{"emotion"}, "0.6", false},
+			{"\U0001f4a6", "sweat droplets", "Smileys & Emotion", "emotion", []string{"emotion"}, "0.6", false},
+			{"\U0001f4a8", "dashing away", "Smileys & Emotion", "emotion", []string{"emotion"}, "0.6", false},
+			{"\U0001f573\ufe0f", "hole", "Smileys & Emotion", "emotion", []string{"emotion"}, "0.7", false},
+			{"\U0001f4ac", "speech balloon", "Smileys & Emotion", "emotion", []string{"emotion"}, "0.6", false},
+			{"\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f", "eye in speech bubble", "Smileys & Emotion", "emotion", []string{"emotion"}, "2.0", false},
+			{"\U0001f5e8\ufe0f", "left speech bubble", "Smileys & Emotion", "emotion", []string{"emotion"}, "2.0", false},
+			{"\U0001f5ef\ufe0f", "right anger bubble", "Smileys & Emotion", "emotion", []string{"emotion"}, "0.7", false},
+			{"\U0001f4ad", "thought balloon", "Smileys & Emotion", "emotion", []string{"emotion"}, "1.0", false},
+			{"\U0001f4a4", "ZZZ", "Smileys & Emotion", "emotion", []string{"emotion"}, "0.6", false},
+			{"\U0001f44b", "waving hand", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "0.6", true},
+			{"\U0001f44b\U0001f3fb", "waving hand: light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001f44b\U0001f3fc", "waving hand: medium-light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001f44b\U0001f3fd", "waving hand: medium skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001f44b\U0001f3fe", "waving hand: medium-dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001f44b\U0001f3ff", "waving hand: dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001f91a", "raised back of hand", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "3.0", true},
+			{"\U0001f91a\U0001f3fb", "raised back of hand: light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "3.0", false},
+			{"\U0001f91a\U0001f3fc", "raised back of hand: medium-light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "3.0", false},
+			{"\U0001f91a\U0001f3fd", "raised back of hand: medium skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "3.0", false},
+			{"\U0001f91a\U0001f3fe", "raised back of hand: medium-dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "3.0", false},
+			{"\U0001f91a\U0001f3ff", "raised back of hand: dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "3.0", false},
+			{"\U0001f590\ufe0f", "hand with fingers splayed", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "0.7", true},
+			{"\U0001f590\U0001f3fb", "hand with fingers splayed: light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001f590\U0001f3fc", "hand with fingers splayed: medium-light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001f590\U0001f3fd", "hand with fingers splayed: medium skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001f590\U0001f3fe", "hand with fingers splayed: medium-dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001f590\U0001f3ff", "hand with fingers splayed: dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\u270b", "raised hand", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "0.6", true},
+			{"\u270b\U0001f3fb", "raised hand: light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\u270b\U0001f3fc", "raised hand: medium-light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\u270b\U0001f3fd", "raised hand: medium skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\u270b\U0001f3fe", "raised hand: medium-dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\u270b\U0001f3ff", "raised hand: dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001f596", "vulcan salute", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", true},
+			{"\U0001f596\U0001f3fb", "vulcan salute: light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001f596\U0001f3fc", "vulcan salute: medium-light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001f596\U0001f3fd", "vulcan salute: medium skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001f596\U0001f3fe", "vulcan salute: medium-dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001f596\U0001f3ff", "vulcan salute: dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "1.0", false},
+			{"\U0001faf1", "rightwards hand", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", true},
+			{"\U0001faf1\U0001f3fb", "rightwards hand: light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf1\U0001f3fc", "rightwards hand: medium-light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf1\U0001f3fd", "rightwards hand: medium skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf1\U0001f3fe", "rightwards hand: medium-dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf1\U0001f3ff", "rightwards hand: dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf2", "leftwards hand", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", true},
+			{"\U0001faf2\U0001f3fb", "leftwards hand: light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf2\U0001f3fc", "leftwards hand: medium-light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf2\U0001f3fd", "leftwards hand: medium skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf2\U0001f3fe", "leftwards hand: medium-dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf2\U0001f3ff", "leftwards hand: dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf3", "palm down hand", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", true},
+			{"\U0001faf3\U0001f3fb", "palm down hand: light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf3\U0001f3fc", "palm down hand: medium-light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf3\U0001f3fd", "palm down hand: medium skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf3\U0001f3fe", "palm down hand: medium-dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf3\U0001f3ff", "palm down hand: dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf4", "palm up hand", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", true},
+			{"\U0001faf4\U0001f3fb", "palm up hand: light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf4\U0001f3fc", "palm up hand: medium-light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf4\U0001f3fd", "palm up hand: medium skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf4\U0001f3fe", "palm up hand: medium-dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf4\U0001f3ff", "palm up hand: dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "14.0", false},
+			{"\U0001faf7", "leftwards pushing hand", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "15.0", true},
+			{"\U0001faf7\U0001f3fb", "leftwards pushing hand: light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "15.0", false},
+			{"\U0001faf7\U0001f3fc", "leftwards pushing hand: medium-light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "15.0", false},
+			{"\U0001faf7\U0001f3fd", "leftwards pushing hand: medium skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "15.0", false},
+			{"\U0001faf7\U0001f3fe", "leftwards pushing hand: medium-dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "15.0", false},
+			{"\U0001faf7\U0001f3ff", "leftwards pushing hand: dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "15.0", false},
+			{"\U0001faf8", "rightwards pushing hand", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "15.0", true},
+			{"\U0001faf8\U0001f3fb", "rightwards pushing hand: light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "15.0", false},
+			{"\U0001faf8\U0001f3fc", "rightwards pushing hand: medium-light skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "15.0", false},
+			{"\U0001faf8\U0001f3fd", "rightwards pushing hand: medium skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "15.0", false},
+			{"\U0001faf8\U0001f3fe", "rightwards pushing hand: medium-dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "15.0", false},
+			{"\U0001faf8\U0001f3ff", "rightwards pushing hand: dark skin tone", "People & Body", "hand-fingers-open", []string{"fingers", "hand", "open"}, "15.0", false},
+			{"\U0001f44c", "OK hand", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "0.6", true},
+			{"\U0001f44c\U0001f3fb", "OK hand: light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\U0001f44c\U0001f3fc", "OK hand: medium-light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\U0001f44c\U0001f3fd", "OK hand: medium skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\U0001f44c\U0001f3fe", "OK hand: medium-dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\U0001f44c\U0001f3ff", "OK hand: dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\U0001f90c", "pinched fingers", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "13.0", true},
+			{"\U0001f90c\U0001f3fb", "pinched fingers: light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "13.0", false},
+			{"\U0001f90c\U0001f3fc", "pinched fingers: medium-light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "13.0", false},
+			{"\U0001f90c\U0001f3fd", "pinched fingers: medium skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "13.0", false},
+			{"\U0001f90c\U0001f3fe", "pinched fingers: medium-dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "13.0", false},
+			{"\U0001f90c\U0001f3ff", "pinched fingers: dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "13.0", false},
+			{"\U0001f90f", "pinching hand", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "12.0", true},
+			{"\U0001f90f\U0001f3fb", "pinching hand: light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "12.0", false},
+			{"\U0001f90f\U0001f3fc", "pinching hand: medium-light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "12.0", false},
+			{"\U0001f90f\U0001f3fd", "pinching hand: medium skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "12.0", false},
+			{"\U0001f90f\U0001f3fe", "pinching hand: medium-dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "12.0", false},
+			{"\U0001f90f\U0001f3ff", "pinching hand: dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "12.0", false},
+			{"\u270c\ufe0f", "victory hand", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "0.6", true},
+			{"\u270c\U0001f3fb", "victory hand: light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\u270c\U0001f3fc", "victory hand: medium-light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\u270c\U0001f3fd", "victory hand: medium skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\u270c\U0001f3fe", "victory hand: medium-dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\u270c\U0001f3ff", "victory hand: dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\U0001f91e", "crossed fingers", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "3.0", true},
+			{"\U0001f91e\U0001f3fb", "crossed fingers: light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "3.0", false},
+			{"\U0001f91e\U0001f3fc", "crossed fingers: medium-light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "3.0", false},
+			{"\U0001f91e\U0001f3fd", "crossed fingers: medium skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "3.0", false},
+			{"\U0001f91e\U0001f3fe", "crossed fingers: medium-dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "3.0", false},
+			{"\U0001f91e\U0001f3ff", "crossed fingers: dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "3.0", false},
+			{"\U0001faf0", "hand with index finger and thumb crossed", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "14.0", true},
+			{"\U0001faf0\U0001f3fb", "hand with index finger and thumb crossed: light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "14.0", false},
+			{"\U0001faf0\U0001f3fc", "hand with index finger and thumb crossed: medium-light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "14.0", false},
+			{"\U0001faf0\U0001f3fd", "hand with index finger and thumb crossed: medium skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "14.0", false},
+			{"\U0001faf0\U0001f3fe", "hand with index finger and thumb crossed: medium-dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "14.0", false},
+			{"\U0001faf0\U0001f3ff", "hand with index finger and thumb crossed: dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "14.0", false},
+			{"\U0001f91f", "love-you gesture", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "5.0", true},
+			{"\U0001f91f\U0001f3fb", "love-you gesture: light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "5.0", false},
+			{"\U0001f91f\U0001f3fc", "love-you gesture: medium-light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "5.0", false},
+			{"\U0001f91f\U0001f3fd", "love-you gesture: medium skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "5.0", false},
+			{"\U0001f91f\U0001f3fe", "love-you gesture: medium-dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "5.0", false},
+			{"\U0001f91f\U0001f3ff", "love-you gesture: dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "5.0", false},
+			{"\U0001f918", "sign of the horns", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", true},
+			{"\U0001f918\U0001f3fb", "sign of the horns: light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\U0001f918\U0001f3fc", "sign of the horns: medium-light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\U0001f918\U0001f3fd", "sign of the horns: medium skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\U0001f918\U0001f3fe", "sign of the horns: medium-dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\U0001f918\U0001f3ff", "sign of the horns: dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "1.0", false},
+			{"\U0001f919", "call me hand", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "3.0", true},
+			{"\U0001f919\U0001f3fb", "call me hand: light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "3.0", false},
+			{"\U0001f919\U0001f3fc", "call me hand: medium-light skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "3.0", false},
+			{"\U0001f919\U0001f3fd", "call me hand: medium skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "3.0", false},
+			{"\U0001f919\U0001f3fe", "call me hand: medium-dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "3.0", false},
+			{"\U0001f919\U0001f3ff", "call me hand: dark skin tone", "People & Body", "hand-fingers-partial", []string{"fingers", "hand", "partial"}, "3.0", false},
+			{"\U0001f448", "backhand index pointing left", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "0.6", true},
+			{"\U0001f448\U0001f3fb", "backhand index pointing left: light skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f448\U0001f3fc", "backhand index pointing left: medium-light skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f448\U0001f3fd", "backhand index pointing left: medium skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f448\U0001f3fe", "backhand index pointing left: medium-dark skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f448\U0001f3ff", "backhand index pointing left: dark skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f449", "backhand index pointing right", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "0.6", true},
+			{"\U0001f449\U0001f3fb", "backhand index pointing right: light skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f449\U0001f3fc", "backhand index pointing right: medium-light skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f449\U0001f3fd", "backhand index pointing right: medium skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f449\U0001f3fe", "backhand index pointing right: medium-dark skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f449\U0001f3ff", "backhand index pointing right: dark skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f446", "backhand index pointing up", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "0.6", true},
+			{"\U0001f446\U0001f3fb", "backhand index pointing up: light skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f446\U0001f3fc", "backhand index pointing up: medium-light skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f446\U0001f3fd", "backhand index pointing up: medium skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f446\U0001f3fe", "backhand index pointing up: medium-dark skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f446\U0001f3ff", "backhand index pointing up: dark skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f595", "middle finger", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", true},
+			{"\U0001f595\U0001f3fb", "middle finger: light skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f595\U0001f3fc", "middle finger: medium-light skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f595\U0001f3fd", "middle finger: medium skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f595\U0001f3fe", "middle finger: medium-dark skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f595\U0001f3ff", "middle finger: dark skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f447", "backhand index pointing down", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "0.6", true},
+			{"\U0001f447\U0001f3fb", "backhand index pointing down: light skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f447\U0001f3fc", "backhand index pointing down: medium-light skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f447\U0001f3fd", "backhand index pointing down: medium skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f447\U0001f3fe", "backhand index pointing down: medium-dark skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001f447\U0001f3ff", "backhand index pointing down: dark skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\u261d\ufe0f", "index pointing up", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "0.6", true},
+			{"\u261d\U0001f3fb", "index pointing up: light skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\u261d\U0001f3fc", "index pointing up: medium-light skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\u261d\U0001f3fd", "index pointing up: medium skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\u261d\U0001f3fe", "index pointing up: medium-dark skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\u261d\U0001f3ff", "index pointing up: dark skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "1.0", false},
+			{"\U0001faf5", "index pointing at the viewer", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "14.0", true},
+			{"\U0001faf5\U0001f3fb", "index pointing at the viewer: light skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "14.0", false},
+			{"\U0001faf5\U0001f3fc", "index pointing at the viewer: medium-light skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "14.0", false},
+			{"\U0001faf5\U0001f3fd", "index pointing at the viewer: medium skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "14.0", false},
+			{"\U0001faf5\U0001f3fe", "index pointing at the viewer: medium-dark skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "14.0", false},
+			{"\U0001faf5\U0001f3ff", "index pointing at the viewer: dark skin tone", "People & Body", "hand-single-finger", []string{"finger", "hand", "single"}, "14.0", false},
+			{"\U0001f44d", "thumbs up", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "0.6", true},
+			{"\U0001f44d\U0001f3fb", "thumbs up: light skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f44d\U0001f3fc", "thumbs up: medium-light skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f44d\U0001f3fd", "thumbs up: medium skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f44d\U0001f3fe", "thumbs up: medium-dark skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f44d\U0001f3ff", "thumbs up: dark skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f44e", "thumbs down", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "0.6", true},
+			{"\U0001f44e\U0001f3fb", "thumbs down: light skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f44e\U0001f3fc", "thumbs down: medium-light skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f44e\U0001f3fd", "thumbs down: medium skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f44e\U0001f3fe", "thumbs down: medium-dark skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f44e\U0001f3ff", "thumbs down: dark skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\u270a", "raised fist", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "0.6", true},
+			{"\u270a\U0001f3fb", "raised fist: light skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\u270a\U0001f3fc", "raised fist: medium-light skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\u270a\U0001f3fd", "raised fist: medium skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\u270a\U0001f3fe", "raised fist: medium-dark skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\u270a\U0001f3ff", "raised fist: dark skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f44a", "oncoming fist", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "0.6", true},
+			{"\U0001f44a\U0001f3fb", "oncoming fist: light skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f44a\U0001f3fc", "oncoming fist: medium-light skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f44a\U0001f3fd", "oncoming fist: medium skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f44a\U0001f3fe", "oncoming fist: medium-dark skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f44a\U0001f3ff", "oncoming fist: dark skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "1.0", false},
+			{"\U0001f91b", "left-facing fist", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "3.0", true},
+			{"\U0001f91b\U0001f3fb", "left-facing fist: light skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "3.0", false},
+			{"\U0001f91b\U0001f3fc", "left-facing fist: medium-light skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "3.0", false},
+			{"\U0001f91b\U0001f3fd", "left-facing fist: medium skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "3.0", false},
+			{"\U0001f91b\U0001f3fe", "left-facing fist: medium-dark skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "3.0", false},
+			{"\U0001f91b\U0001f3ff", "left-facing fist: dark skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "3.0", false},
+			{"\U0001f91c", "right-facing fist", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "3.0", true},
+			{"\U0001f91c\U0001f3fb", "right-facing fist: light skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "3.0", false},
+			{"\U0001f91c\U0001f3fc", "right-facing fist: medium-light skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "3.0", false},
+			{"\U0001f91c\U0001f3fd", "right-facing fist: medium skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "3.0", false},
+			{"\U0001f91c\U0001f3fe", "right-facing fist: medium-dark skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "3.0", false},
+			{"\U0001f91c\U0001f3ff", "right-facing fist: dark skin tone", "People & Body", "hand-fingers-closed", []string{"closed", "fingers", "hand"}, "3.0", false},
+			{"\U0001f44f", "clapping hands", "People & Body", "hands", []string{"hands"}, "0.6", true},
+			{"\U0001f44f\U0001f3fb", "clapping hands: light skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f44f\U0001f3fc", "clapping hands: medium-light skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f44f\U0001f3fd", "clapping hands: medium skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f44f\U0001f3fe", "clapping hands: medium-dark skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f44f\U0001f3ff", "clapping hands: dark skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f64c", "raising hands", "People & Body", "hands", []string{"hands"}, "0.6", true},
+			{"\U0001f64c\U0001f3fb", "raising hands: light skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f64c\U0001f3fc", "raising hands: medium-light skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f64c\U0001f3fd", "raising hands: medium skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f64c\U0001f3fe", "raising hands: medium-dark skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f64c\U0001f3ff", "raising hands: dark skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001faf6", "heart hands", "People & Body", "hands", []string{"hands"}, "14.0", true},
+			{"\U0001faf6\U0001f3fb", "heart hands: light skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf6\U0001f3fc", "heart hands: medium-light skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf6\U0001f3fd", "heart hands: medium skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf6\U0001f3fe", "heart hands: medium-dark skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf6\U0001f3ff", "heart hands: dark skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001f450", "open hands", "People & Body", "hands", []string{"hands"}, "0.6", true},
+			{"\U0001f450\U0001f3fb", "open hands: light skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f450\U0001f3fc", "open hands: medium-light skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f450\U0001f3fd", "open hands: medium skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f450\U0001f3fe", "open hands: medium-dark skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f450\U0001f3ff", "open hands: dark skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f932", "palms up together", "People & Body", "hands", []string{"hands"}, "5.0", true},
+			{"\U0001f932\U0001f3fb", "palms up together: light skin tone", "People & Body", "hands", []string{"hands"}, "5.0", false},
+			{"\U0001f932\U0001f3fc", "palms up together: medium-light skin tone", "People & Body", "hands", []string{"hands"}, "5.0", false},
+			{"\U0001f932\U0001f3fd", "palms up together: medium skin tone", "People & Body", "hands", []string{"hands"}, "5.0", false},
+			{"\U0001f932\U0001f3fe", "palms up together: medium-dark skin tone", "People & Body", "hands", []string{"hands"}, "5.0", false},
+			{"\U0001f932\U0001f3ff", "palms up together: dark skin tone", "People & Body", "hands", []string{"hands"}, "5.0", false},
+			{"\U0001f91d", "handshake", "People & Body", "hands", []string{"hands"}, "3.0", true},
+			{"\U0001f91d\U0001f3fb", "handshake: light skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001f91d\U0001f3fc", "handshake: medium-light skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001f91d\U0001f3fd", "handshake: medium skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001f91d\U0001f3fe", "handshake: medium-dark skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001f91d\U0001f3ff", "handshake: dark skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fb\u200d\U0001faf2\U0001f3fc", "handshake: light skin tone, medium-light skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fb\u200d\U0001faf2\U0001f3fd", "handshake: light skin tone, medium skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fb\u200d\U0001faf2\U0001f3fe", "handshake: light skin tone, medium-dark skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fb\u200d\U0001faf2\U0001f3ff", "handshake: light skin tone, dark skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fc\u200d\U0001faf2\U0001f3fb", "handshake: medium-light skin tone, light skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fc\u200d\U0001faf2\U0001f3fd", "handshake: medium-light skin tone, medium skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fc\u200d\U0001faf2\U0001f3fe", "handshake: medium-light skin tone, medium-dark skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fc\u200d\U0001faf2\U0001f3ff", "handshake: medium-light skin tone, dark skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fd\u200d\U0001faf2\U0001f3fb", "handshake: medium skin tone, light skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fd\u200d\U0001faf2\U0001f3fc", "handshake: medium skin tone, medium-light skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fd\u200d\U0001faf2\U0001f3fe", "handshake: medium skin tone, medium-dark skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fd\u200d\U0001faf2\U0001f3ff", "handshake: medium skin tone, dark skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fe\u200d\U0001faf2\U0001f3fb", "handshake: medium-dark skin tone, light skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fe\u200d\U0001faf2\U0001f3fc", "handshake: medium-dark skin tone, medium-light skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fe\u200d\U0001faf2\U0001f3fd", "handshake: medium-dark skin tone, medium skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3fe\u200d\U0001faf2\U0001f3ff", "handshake: medium-dark skin tone, dark skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3ff\u200d\U0001faf2\U0001f3fb", "handshake: dark skin tone, light skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3ff\u200d\U0001faf2\U0001f3fc", "handshake: dark skin tone, medium-light skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3ff\u200d\U0001faf2\U0001f3fd", "handshake: dark skin tone, medium skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001faf1\U0001f3ff\u200d\U0001faf2\U0001f3fe", "handshake: dark skin tone, medium-dark skin tone", "People & Body", "hands", []string{"hands"}, "14.0", false},
+			{"\U0001f64f", "folded hands", "People & Body", "hands", []string{"hands"}, "0.6", true},
+			{"\U0001f64f\U0001f3fb", "folded hands: light skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f64f\U0001f3fc", "folded hands: medium-light skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f64f\U0001f3fd", "folded hands: medium skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f64f\U0001f3fe", "folded hands: medium-dark skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\U0001f64f\U0001f3ff", "folded hands: dark skin tone", "People & Body", "hands", []string{"hands"}, "1.0", false},
+			{"\u270d\ufe0f", "writing hand", "People & Body", "hand-prop", []string{"hand", "prop"}, "0.7", true},
+			{"\u270d\U0001f3fb", "writing hand: light skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "1.0", false},
+			{"\u270d\U0001f3fc", "writing hand: medium-light skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "1.0", false},
+			{"\u270d\U0001f3fd", "writing hand: medium skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "1.0", false},
+			{"\u270d\U0001f3fe", "writing hand: medium-dark skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "1.0", false},
+			{"\u270d\U0001f3ff", "writing hand: dark skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "1.0", false},
+			{"\U0001f485", "nail polish", "People & Body", "hand-prop", []string{"hand", "prop"}, "0.6", true},
+			{"\U0001f485\U0001f3fb", "nail polish: light skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "1.0", false},
+			{"\U0001f485\U0001f3fc", "nail polish: medium-light skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "1.0", false},
+			{"\U0001f485\U0001f3fd", "nail polish: medium skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "1.0", false},
+			{"\U0001f485\U0001f3fe", "nail polish: medium-dark skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "1.0", false},
+			{"\U0001f485\U0001f3ff", "nail polish: dark skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "1.0", false},
+			{"\U0001f933", "selfie", "People & Body", "hand-prop", []string{"hand", "prop"}, "3.0", true},
+			{"\U0001f933\U0001f3fb", "selfie: light skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "3.0", false},
+			{"\U0001f933\U0001f3fc", "selfie: medium-light skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "3.0", false},
+			{"\U0001f933\U0001f3fd", "selfie: medium skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "3.0", false},
+			{"\U0001f933\U0001f3fe", "selfie: medium-dark skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "3.0", false},
+			{"\U0001f933\U0001f3ff", "selfie: dark skin tone", "People & Body", "hand-prop", []string{"hand", "prop"}, "3.0", false},
+			{"\U0001f4aa", "flexed biceps", "People & Body", "body-parts", []string{"body", "parts"}, "0.6", true},
+			{"\U0001f4aa\U0001f3fb", "flexed biceps: light skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f4aa\U0001f3fc", "flexed biceps: medium-light skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f4aa\U0001f3fd", "flexed biceps: medium skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f4aa\U0001f3fe", "flexed biceps: medium-dark skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f4aa\U0001f3ff", "flexed biceps: dark skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f9be", "mechanical arm", "People & Body", "body-parts", []string{"body", "parts"}, "12.0", false},
+			{"\U0001f9bf", "mechanical leg", "People & Body", "body-parts", []string{"body", "parts"}, "12.0", false},
+			{"\U0001f9b5", "leg", "People & Body", "body-parts", []string{"body", "parts"}, "11.0", true},
+			{"\U0001f9b5\U0001f3fb", "leg: light skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "11.0", false},
+			{"\U0001f9b5\U0001f3fc", "leg: medium-light skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "11.0", false},
+			{"\U0001f9b5\U0001f3fd", "leg: medium skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "11.0", false},
+			{"\U0001f9b5\U0001f3fe", "leg: medium-dark skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "11.0", false},
+			{"\U0001f9b5\U0001f3ff", "leg: dark skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "11.0", false},
+			{"\U0001f9b6", "foot", "People & Body", "body-parts", []string{"body", "parts"}, "11.0", true},
+			{"\U0001f9b6\U0001f3fb", "foot: light skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "11.0", false},
+			{"\U0001f9b6\U0001f3fc", "foot: medium-light skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "11.0", false},
+			{"\U0001f9b6\U0001f3fd", "foot: medium skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "11.0", false},
+			{"\U0001f9b6\U0001f3fe", "foot: medium-dark skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "11.0", false},
+			{"\U0001f9b6\U0001f3ff", "foot: dark skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "11.0", false},
+			{"\U0001f442", "ear", "People & Body", "body-parts", []string{"body", "parts"}, "0.6", true},
+			{"\U0001f442\U0001f3fb", "ear: light skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f442\U0001f3fc", "ear: medium-light skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f442\U0001f3fd", "ear: medium skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f442\U0001f3fe", "ear: medium-dark skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f442\U0001f3ff", "ear: dark skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f9bb", "ear with hearing aid", "People & Body", "body-parts", []string{"body", "parts"}, "12.0", true},
+			{"\U0001f9bb\U0001f3fb", "ear with hearing aid: light skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "12.0", false},
+			{"\U0001f9bb\U0001f3fc", "ear with hearing aid: medium-light skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "12.0", false},
+			{"\U0001f9bb\U0001f3fd", "ear with hearing aid: medium skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "12.0", false},
+			{"\U0001f9bb\U0001f3fe", "ear with hearing aid: medium-dark skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "12.0", false},
+			{"\U0001f9bb\U0001f3ff", "ear with hearing aid: dark skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "12.0", false},
+			{"\U0001f443", "nose", "People & Body", "body-parts", []string{"body", "parts"}, "0.6", true},
+			{"\U0001f443\U0001f3fb", "nose: light skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f443\U0001f3fc", "nose: medium-light skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f443\U0001f3fd", "nose: medium skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f443\U0001f3fe", "nose: medium-dark skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f443\U0001f3ff", "nose: dark skin tone", "People & Body", "body-parts", []string{"body", "parts"}, "1.0", false},
+			{"\U0001f9e0", "brain", "People & Body", "body-parts", []string{"body", "parts"}, "5.0", false},
+			{"\U0001fac0", "anatomical heart", "People & Body", "body-parts", []string{"body", "parts"}, "13.0", false},
+			{"\U0001fac1", "lungs", "People & Body", "body-parts", []string{"body", "parts"}, "13.0", false},
+			{"\U0001f9b7", "tooth", "People & Body", "body-parts", []string{"body", "parts"}, "11.0", false},
+			{"\U0001f9b4", "bone", "People & Body", "body-parts", []string{"body", "parts"}, "11.0", false},
+			{"\U0001f440", "eyes", "People & Body", "body-parts", []string{"body", "parts"}, "0.6", false},
+			{"\U0001f441\ufe0f", "eye", "People & Body", "body-parts", []string{"body", "parts"}, "0.7", false},
+			{"\U0001f445", "tongue", "People & Body", "body-parts", []string{"body", "parts"}, "0.6", false},
+			{"\U0001f444", "mouth", "People & Body", "body-parts", []string{"body", "parts"}, "0.6", false},
+			{"\U0001fae6", "biting lip", "People & Body", "body-parts", []string{"body", "parts"}, "14.0", false},
+			{"\U0001f476", "baby", "People & Body", "person", []string{"person"}, "0.6", true},
+			{"\U0001f476\U0001f3fb", "baby: light skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f476\U0001f3fc", "baby: medium-light skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f476\U0001f3fd", "baby: medium skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f476\U0001f3fe", "baby: medium-dark skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f476\U0001f3ff", "baby: dark skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f9d2", "child", "People & Body", "person", []string{"person"}, "5.0", true},
+			{"\U0001f9d2\U0001f3fb", "child: light skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d2\U0001f3fc", "child: medium-light skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d2\U0001f3fd", "child: medium skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d2\U0001f3fe", "child: medium-dark skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d2\U0001f3ff", "child: dark skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f466", "boy", "People & Body", "person", []string{"person"}, "0.6", true},
+			{"\U0001f466\U0001f3fb", "boy: light skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f466\U0001f3fc", "boy: medium-light skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f466\U0001f3fd", "boy: medium skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f466\U0001f3fe", "boy: medium-dark skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f466\U0001f3ff", "boy: dark skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f467", "girl", "People & Body", "person", []string{"person"}, "0.6", true},
+			{"\U0001f467\U0001f3fb", "girl: light skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f467\U0001f3fc", "girl: medium-light skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f467\U0001f3fd", "girl: medium skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f467\U0001f3fe", "girl: medium-dark skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f467\U0001f3ff", "girl: dark skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f9d1", "person", "People & Body", "person", []string{"person"}, "5.0", true},
+			{"\U0001f9d1\U0001f3fb", "person: light skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d1\U0001f3fc", "person: medium-light skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d1\U0001f3fd", "person: medium skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d1\U0001f3fe", "person: medium-dark skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d1\U0001f3ff", "person: dark skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f471", "person: blond hair", "People & Body", "person", []string{"person"}, "0.6", false},
+			{"\U0001f471\U0001f3fb", "person: light skin tone, blond hair", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f471\U0001f3fc", "person: medium-light skin tone, blond hair", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f471\U0001f3fd", "person: medium skin tone, blond hair", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f471\U0001f3fe", "person: medium-dark skin tone, blond hair", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f471\U0001f3ff", "person: dark skin tone, blond hair", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f468", "man", "People & Body", "person", []string{"person"}, "0.6", true},
+			{"\U0001f468\U0001f3fb", "man: light skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f468\U0001f3fc", "man: medium-light skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f468\U0001f3fd", "man: medium skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f468\U0001f3fe", "man: medium-dark skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f468\U0001f3ff", "man: dark skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f9d4", "person: beard", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d4\U0001f3fb", "person: light skin tone, beard", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d4\U0001f3fc", "person: medium-light skin tone, beard", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d4\U0001f3fd", "person: medium skin tone, beard", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d4\U0001f3fe", "person: medium-dark skin tone, beard", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d4\U0001f3ff", "person: dark skin tone, beard", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d4\u200d\u2642\ufe0f", "man: beard", "People & Body", "person", []string{"person"}, "13.1", false},
+			{"\U0001f9d4\U0001f3fb\u200d\u2642\ufe0f", "man: light skin tone, beard", "People & Body", "person", []string{"person"}, "13.1", false},
+			{"\U0001f9d4\U0001f3fc\u200d\u2642\ufe0f", "man: medium-light skin tone, beard", "People & Body", "person", []string{"person"}, "13.1", false},
+			{"\U0001f9d4\U0001f3fd\u200d\u2642\ufe0f", "man: medium skin tone, beard", "People & Body", "person", []string{"person"}, "13.1", false},
+			{"\U0001f9d4\U0001f3fe\u200d\u2642\ufe0f", "man: medium-dark skin tone, beard", "People & Body", "person", []string{"person"}, "13.1", false},
+			{"\U0001f9d4\U0001f3ff\u200d\u2642\ufe0f", "man: dark skin tone, beard", "People & Body", "person", []string{"person"}, "13.1", false},
+			{"\U0001f9d4\u200d\u2640\ufe0f", "woman: beard", "People & Body", "person", []string{"person"}, "13.1", false},
+			{"\U0001f9d4\U0001f3fb\u200d\u2640\ufe0f", "woman: light skin tone, beard", "People & Body", "person", []string{"person"}, "13.1", false},
+			{"\U0001f9d4\U0001f3fc\u200d\u2640\ufe0f", "woman: medium-light skin tone, beard", "People & Body", "person", []string{"person"}, "13.1", false},
+			{"\U0001f9d4\U0001f3fd\u200d\u2640\ufe0f", "woman: medium skin tone, beard", "People & Body", "person", []string{"person"}, "13.1", false},
+			{"\U0001f9d4\U0001f3fe\u200d\u2640\ufe0f", "woman: medium-dark skin tone, beard", "People & Body", "person", []string{"person"}, "13.1", false},
+			{"\U0001f9d4\U0001f3ff\u200d\u2640\ufe0f", "woman: dark skin tone, beard", "People & Body", "person", []string{"person"}, "13.1", false},
+			{"\U0001f468\u200d\U0001f9b0", "man: red hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fb\u200d\U0001f9b0", "man: light skin tone, red hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f9b0", "man: medium-light skin tone, red hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f9b0", "man: medium skin tone, red hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f9b0", "man: medium-dark skin tone, red hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f9b0", "man: dark skin tone, red hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\u200d\U0001f9b1", "man: curly hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fb\u200d\U0001f9b1", "man: light skin tone, curly hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f9b1", "man: medium-light skin tone, curly hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f9b1", "man: medium skin tone, curly hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f9b1", "man: medium-dark skin tone, curly hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f9b1", "man: dark skin tone, curly hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\u200d\U0001f9b3", "man: white hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fb\u200d\U0001f9b3", "man: light skin tone, white hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f9b3", "man: medium-light skin tone, white hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f9b3", "man: medium skin tone, white hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f9b3", "man: medium-dark skin tone, white hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f9b3", "man: dark skin tone, white hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\u200d\U0001f9b2", "man: bald", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fb\u200d\U0001f9b2", "man: light skin tone, bald", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f9b2", "man: medium-light skin tone, bald", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f9b2", "man: medium skin tone, bald", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f9b2", "man: medium-dark skin tone, bald", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f9b2", "man: dark skin tone, bald", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469", "woman", "People & Body", "person", []string{"person"}, "0.6", true},
+			{"\U0001f469\U0001f3fb", "woman: light skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f469\U0001f3fc", "woman: medium-light skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f469\U0001f3fd", "woman: medium skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f469\U0001f3fe", "woman: medium-dark skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f469\U0001f3ff", "woman: dark skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f469\u200d\U0001f9b0", "woman: red hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fb\u200d\U0001f9b0", "woman: light skin tone, red hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f9b0", "woman: medium-light skin tone, red hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f9b0", "woman: medium skin tone, red hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f9b0", "woman: medium-dark skin tone, red hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f9b0", "woman: dark skin tone, red hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f9d1\u200d\U0001f9b0", "person: red hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f9b0", "person: light skin tone, red hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f9b0", "person: medium-light skin tone, red hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f9b0", "person: medium skin tone, red hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f9b0", "person: medium-dark skin tone, red hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f9b0", "person: dark skin tone, red hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f469\u200d\U0001f9b1", "woman: curly hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fb\u200d\U0001f9b1", "woman: light skin tone, curly hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f9b1", "woman: medium-light skin tone, curly hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f9b1", "woman: medium skin tone, curly hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f9b1", "woman: medium-dark skin tone, curly hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f9b1", "woman: dark skin tone, curly hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f9d1\u200d\U0001f9b1", "person: curly hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f9b1", "person: light skin tone, curly hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f9b1", "person: medium-light skin tone, curly hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f9b1", "person: medium skin tone, curly hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f9b1", "person: medium-dark skin tone, curly hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f9b1", "person: dark skin tone, curly hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f469\u200d\U0001f9b3", "woman: white hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fb\u200d\U0001f9b3", "woman: light skin tone, white hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f9b3", "woman: medium-light skin tone, white hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f9b3", "woman: medium skin tone, white hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f9b3", "woman: medium-dark skin tone, white hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f9b3", "woman: dark skin tone, white hair", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f9d1\u200d\U0001f9b3", "person: white hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f9b3", "person: light skin tone, white hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f9b3", "person: medium-light skin tone, white hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f9b3", "person: medium skin tone, white hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f9b3", "person: medium-dark skin tone, white hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f9b3", "person: dark skin tone, white hair", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f469\u200d\U0001f9b2", "woman: bald", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fb\u200d\U0001f9b2", "woman: light skin tone, bald", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f9b2", "woman: medium-light skin tone, bald", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f9b2", "woman: medium skin tone, bald", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f9b2", "woman: medium-dark skin tone, bald", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f9b2", "woman: dark skin tone, bald", "People & Body", "person", []string{"person"}, "11.0", false},
+			{"\U0001f9d1\u200d\U0001f9b2", "person: bald", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f9b2", "person: light skin tone, bald", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f9b2", "person: medium-light skin tone, bald", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f9b2", "person: medium skin tone, bald", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f9b2", "person: medium-dark skin tone, bald", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f9b2", "person: dark skin tone, bald", "People & Body", "person", []string{"person"}, "12.1", false},
+			{"\U0001f471\u200d\u2640\ufe0f", "woman: blond hair", "People & Body", "person", []string{"person"}, "4.0", false},
+			{"\U0001f471\U0001f3fb\u200d\u2640\ufe0f", "woman: light skin tone, blond hair", "People & Body", "person", []string{"person"}, "4.0", false},
+			{"\U0001f471\U0001f3fc\u200d\u2640\ufe0f", "woman: medium-light skin tone, blond hair", "People & Body", "person", []string{"person"}, "4.0", false},
+			{"\U0001f471\U0001f3fd\u200d\u2640\ufe0f", "woman: medium skin tone, blond hair", "People & Body", "person", []string{"person"}, "4.0", false},
+			{"\U0001f471\U0001f3fe\u200d\u2640\ufe0f", "woman: medium-dark skin tone, blond hair", "People & Body", "person", []string{"person"}, "4.0", false},
+			{"\U0001f471\U0001f3ff\u200d\u2640\ufe0f", "woman: dark skin tone, blond hair", "People & Body", "person", []string{"person"}, "4.0", false},
+			{"\U0001f471\u200d\u2642\ufe0f", "man: blond hair", "People & Body", "person", []string{"person"}, "4.0", false},
+			{"\U0001f471\U0001f3fb\u200d\u2642\ufe0f", "man: light skin tone, blond hair", "People & Body", "person", []string{"person"}, "4.0", false},
+			{"\U0001f471\U0001f3fc\u200d\u2642\ufe0f", "man: medium-light skin tone, blond hair", "People & Body", "person", []string{"person"}, "4.0", false},
+			{"\U0001f471\U0001f3fd\u200d\u2642\ufe0f", "man: medium skin tone, blond hair", "People & Body", "person", []string{"person"}, "4.0", false},
+			{"\U0001f471\U0001f3fe\u200d\u2642\ufe0f", "man: medium-dark skin tone, blond hair", "People & Body", "person", []string{"person"}, "4.0", false},
+			{"\U0001f471\U0001f3ff\u200d\u2642\ufe0f", "man: dark skin tone, blond hair", "People & Body", "person", []string{"person"}, "4.0", false},
+			{"\U0001f9d3", "older person", "People & Body", "person", []string{"person"}, "5.0", true},
+			{"\U0001f9d3\U0001f3fb", "older person: light skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d3\U0001f3fc", "older person: medium-light skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d3\U0001f3fd", "older person: medium skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d3\U0001f3fe", "older person: medium-dark skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f9d3\U0001f3ff", "older person: dark skin tone", "People & Body", "person", []string{"person"}, "5.0", false},
+			{"\U0001f474", "old man", "People & Body", "person", []string{"person"}, "0.6", true},
+			{"\U0001f474\U0001f3fb", "old man: light skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f474\U0001f3fc", "old man: medium-light skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f474\U0001f3fd", "old man: medium skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f474\U0001f3fe", "old man: medium-dark skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f474\U0001f3ff", "old man: dark skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f475", "old woman", "People & Body", "person", []string{"person"}, "0.6", true},
+			{"\U0001f475\U0001f3fb", "old woman: light skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f475\U0001f3fc", "old woman: medium-light skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f475\U0001f3fd", "old woman: medium skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f475\U0001f3fe", "old woman: medium-dark skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f475\U0001f3ff", "old woman: dark skin tone", "People & Body", "person", []string{"person"}, "1.0", false},
+			{"\U0001f64d", "person frowning", "People & Body", "person-gesture", []string{"gesture", "person"}, "0.6", true},
+			{"\U0001f64d\U0001f3fb", "person frowning: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64d\U0001f3fc", "person frowning: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64d\U0001f3fd", "person frowning: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64d\U0001f3fe", "person frowning: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64d\U0001f3ff", "person frowning: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64d\u200d\u2642\ufe0f", "man frowning", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f64d\U0001f3fb\u200d\u2642\ufe0f", "man frowning: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64d\U0001f3fc\u200d\u2642\ufe0f", "man frowning: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64d\U0001f3fd\u200d\u2642\ufe0f", "man frowning: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64d\U0001f3fe\u200d\u2642\ufe0f", "man frowning: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64d\U0001f3ff\u200d\u2642\ufe0f", "man frowning: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64d\u200d\u2640\ufe0f", "woman frowning", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f64d\U0001f3fb\u200d\u2640\ufe0f", "woman frowning: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64d\U0001f3fc\u200d\u2640\ufe0f", "woman frowning: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64d\U0001f3fd\u200d\u2640\ufe0f", "woman frowning: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64d\U0001f3fe\u200d\u2640\ufe0f", "woman frowning: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64d\U0001f3ff\u200d\u2640\ufe0f", "woman frowning: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64e", "person pouting", "People & Body", "person-gesture", []string{"gesture", "person"}, "0.6", true},
+			{"\U0001f64e\U0001f3fb", "person pouting: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64e\U0001f3fc", "person pouting: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64e\U0001f3fd", "person pouting: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64e\U0001f3fe", "person pouting: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64e\U0001f3ff", "person pouting: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64e\u200d\u2642\ufe0f", "man pouting", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f64e\U0001f3fb\u200d\u2642\ufe0f", "man pouting: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64e\U0001f3fc\u200d\u2642\ufe0f", "man pouting: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64e\U0001f3fd\u200d\u2642\ufe0f", "man pouting: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64e\U0001f3fe\u200d\u2642\ufe0f", "man pouting: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64e\U0001f3ff\u200d\u2642\ufe0f", "man pouting: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64e\u200d\u2640\ufe0f", "woman pouting", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f64e\U0001f3fb\u200d\u2640\ufe0f", "woman pouting: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64e\U0001f3fc\u200d\u2640\ufe0f", "woman pouting: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64e\U0001f3fd\u200d\u2640\ufe0f", "woman pouting: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64e\U0001f3fe\u200d\u2640\ufe0f", "woman pouting: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64e\U0001f3ff\u200d\u2640\ufe0f", "woman pouting: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f645", "person gesturing NO", "People & Body", "person-gesture", []string{"gesture", "person"}, "0.6", true},
+			{"\U0001f645\U0001f3fb", "person gesturing NO: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f645\U0001f3fc", "person gesturing NO: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f645\U0001f3fd", "person gesturing NO: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f645\U0001f3fe", "person gesturing NO: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f645\U0001f3ff", "person gesturing NO: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f645\u200d\u2642\ufe0f", "man gesturing NO", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f645\U0001f3fb\u200d\u2642\ufe0f", "man gesturing NO: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f645\U0001f3fc\u200d\u2642\ufe0f", "man gesturing NO: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f645\U0001f3fd\u200d\u2642\ufe0f", "man gesturing NO: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f645\U0001f3fe\u200d\u2642\ufe0f", "man gesturing NO: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f645\U0001f3ff\u200d\u2642\ufe0f", "man gesturing NO: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f645\u200d\u2640\ufe0f", "woman gesturing NO", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f645\U0001f3fb\u200d\u2640\ufe0f", "woman gesturing NO: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f645\U0001f3fc\u200d\u2640\ufe0f", "woman gesturing NO: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f645\U0001f3fd\u200d\u2640\ufe0f", "woman gesturing NO: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f645\U0001f3fe\u200d\u2640\ufe0f", "woman gesturing NO: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f645\U0001f3ff\u200d\u2640\ufe0f", "woman gesturing NO: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f646", "person gesturing OK", "People & Body", "person-gesture", []string{"gesture", "person"}, "0.6", true},
+			{"\U0001f646\U0001f3fb", "person gesturing OK: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f646\U0001f3fc", "person gesturing OK: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f646\U0001f3fd", "person gesturing OK: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f646\U0001f3fe", "person gesturing OK: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f646\U0001f3ff", "person gesturing OK: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f646\u200d\u2642\ufe0f", "man gesturing OK", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f646\U0001f3fb\u200d\u2642\ufe0f", "man gesturing OK: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f646\U0001f3fc\u200d\u2642\ufe0f", "man gesturing OK: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f646\U0001f3fd\u200d\u2642\ufe0f", "man gesturing OK: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f646\U0001f3fe\u200d\u2642\ufe0f", "man gesturing OK: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f646\U0001f3ff\u200d\u2642\ufe0f", "man gesturing OK: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f646\u200d\u2640\ufe0f", "woman gesturing OK", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f646\U0001f3fb\u200d\u2640\ufe0f", "woman gesturing OK: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f646\U0001f3fc\u200d\u2640\ufe0f", "woman gesturing OK: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f646\U0001f3fd\u200d\u2640\ufe0f", "woman gesturing OK: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f646\U0001f3fe\u200d\u2640\ufe0f", "woman gesturing OK: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f646\U0001f3ff\u200d\u2640\ufe0f", "woman gesturing OK: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f481", "person tipping hand", "People & Body", "person-gesture", []string{"gesture", "person"}, "0.6", true},
+			{"\U0001f481\U0001f3fb", "person tipping hand: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f481\U0001f3fc", "person tipping hand: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f481\U0001f3fd", "person tipping hand: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f481\U0001f3fe", "person tipping hand: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f481\U0001f3ff", "person tipping hand: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f481\u200d\u2642\ufe0f", "man tipping hand", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f481\U0001f3fb\u200d\u2642\ufe0f", "man tipping hand: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f481\U0001f3fc\u200d\u2642\ufe0f", "man tipping hand: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f481\U0001f3fd\u200d\u2642\ufe0f", "man tipping hand: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f481\U0001f3fe\u200d\u2642\ufe0f", "man tipping hand: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f481\U0001f3ff\u200d\u2642\ufe0f", "man tipping hand: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f481\u200d\u2640\ufe0f", "woman tipping hand", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f481\U0001f3fb\u200d\u2640\ufe0f", "woman tipping hand: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f481\U0001f3fc\u200d\u2640\ufe0f", "woman tipping hand: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f481\U0001f3fd\u200d\u2640\ufe0f", "woman tipping hand: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f481\U0001f3fe\u200d\u2640\ufe0f", "woman tipping hand: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f481\U0001f3ff\u200d\u2640\ufe0f", "woman tipping hand: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64b", "person raising hand", "People & Body", "person-gesture", []string{"gesture", "person"}, "0.6", true},
+			{"\U0001f64b\U0001f3fb", "person raising hand: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64b\U0001f3fc", "person raising hand: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64b\U0001f3fd", "person raising hand: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64b\U0001f3fe", "person raising hand: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64b\U0001f3ff", "person raising hand: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f64b\u200d\u2642\ufe0f", "man raising hand", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f64b\U0001f3fb\u200d\u2642\ufe0f", "man raising hand: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64b\U0001f3fc\u200d\u2642\ufe0f", "man raising hand: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64b\U0001f3fd\u200d\u2642\ufe0f", "man raising hand: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64b\U0001f3fe\u200d\u2642\ufe0f", "man raising hand: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64b\U0001f3ff\u200d\u2642\ufe0f", "man raising hand: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64b\u200d\u2640\ufe0f", "woman raising hand", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f64b\U0001f3fb\u200d\u2640\ufe0f", "woman raising hand: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64b\U0001f3fc\u200d\u2640\ufe0f", "woman raising hand: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64b\U0001f3fd\u200d\u2640\ufe0f", "woman raising hand: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64b\U0001f3fe\u200d\u2640\ufe0f", "woman raising hand: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f64b\U0001f3ff\u200d\u2640\ufe0f", "woman raising hand: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f9cf", "deaf person", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", true},
+			{"\U0001f9cf\U0001f3fb", "deaf person: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f9cf\U0001f3fc", "deaf person: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f9cf\U0001f3fd", "deaf person: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f9cf\U0001f3fe", "deaf person: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f9cf\U0001f3ff", "deaf person: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f9cf\u200d\u2642\ufe0f", "deaf man", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", true},
+			{"\U0001f9cf\U0001f3fb\u200d\u2642\ufe0f", "deaf man: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f9cf\U0001f3fc\u200d\u2642\ufe0f", "deaf man: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f9cf\U0001f3fd\u200d\u2642\ufe0f", "deaf man: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f9cf\U0001f3fe\u200d\u2642\ufe0f", "deaf man: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f9cf\U0001f3ff\u200d\u2642\ufe0f", "deaf man: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f9cf\u200d\u2640\ufe0f", "deaf woman", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", true},
+			{"\U0001f9cf\U0001f3fb\u200d\u2640\ufe0f", "deaf woman: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f9cf\U0001f3fc\u200d\u2640\ufe0f", "deaf woman: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f9cf\U0001f3fd\u200d\u2640\ufe0f", "deaf woman: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f9cf\U0001f3fe\u200d\u2640\ufe0f", "deaf woman: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f9cf\U0001f3ff\u200d\u2640\ufe0f", "deaf woman: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "12.0", false},
+			{"\U0001f647", "person bowing", "People & Body", "person-gesture", []string{"gesture", "person"}, "0.6", true},
+			{"\U0001f647\U0001f3fb", "person bowing: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f647\U0001f3fc", "person bowing: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f647\U0001f3fd", "person bowing: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f647\U0001f3fe", "person bowing: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f647\U0001f3ff", "person bowing: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "1.0", false},
+			{"\U0001f647\u200d\u2642\ufe0f", "man bowing", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f647\U0001f3fb\u200d\u2642\ufe0f", "man bowing: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f647\U0001f3fc\u200d\u2642\ufe0f", "man bowing: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f647\U0001f3fd\u200d\u2642\ufe0f", "man bowing: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f647\U0001f3fe\u200d\u2642\ufe0f", "man bowing: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f647\U0001f3ff\u200d\u2642\ufe0f", "man bowing: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f647\u200d\u2640\ufe0f", "woman bowing", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f647\U0001f3fb\u200d\u2640\ufe0f", "woman bowing: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f647\U0001f3fc\u200d\u2640\ufe0f", "woman bowing: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f647\U0001f3fd\u200d\u2640\ufe0f", "woman bowing: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f647\U0001f3fe\u200d\u2640\ufe0f", "woman bowing: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f647\U0001f3ff\u200d\u2640\ufe0f", "woman bowing: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f926", "person facepalming", "People & Body", "person-gesture", []string{"gesture", "person"}, "3.0", true},
+			{"\U0001f926\U0001f3fb", "person facepalming: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "3.0", false},
+			{"\U0001f926\U0001f3fc", "person facepalming: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "3.0", false},
+			{"\U0001f926\U0001f3fd", "person facepalming: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "3.0", false},
+			{"\U0001f926\U0001f3fe", "person facepalming: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "3.0", false},
+			{"\U0001f926\U0001f3ff", "person facepalming: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "3.0", false},
+			{"\U0001f926\u200d\u2642\ufe0f", "man facepalming", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f926\U0001f3fb\u200d\u2642\ufe0f", "man facepalming: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f926\U0001f3fc\u200d\u2642\ufe0f", "man facepalming: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f926\U0001f3fd\u200d\u2642\ufe0f", "man facepalming: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f926\U0001f3fe\u200d\u2642\ufe0f", "man facepalming: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f926\U0001f3ff\u200d\u2642\ufe0f", "man facepalming: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f926\u200d\u2640\ufe0f", "woman facepalming", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f926\U0001f3fb\u200d\u2640\ufe0f", "woman facepalming: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f926\U0001f3fc\u200d\u2640\ufe0f", "woman facepalming: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f926\U0001f3fd\u200d\u2640\ufe0f", "woman facepalming: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f926\U0001f3fe\u200d\u2640\ufe0f", "woman facepalming: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f926\U0001f3ff\u200d\u2640\ufe0f", "woman facepalming: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f937", "person shrugging", "People & Body", "person-gesture", []string{"gesture", "person"}, "3.0", true},
+			{"\U0001f937\U0001f3fb", "person shrugging: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "3.0", false},
+			{"\U0001f937\U0001f3fc", "person shrugging: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "3.0", false},
+			{"\U0001f937\U0001f3fd", "person shrugging: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "3.0", false},
+			{"\U0001f937\U0001f3fe", "person shrugging: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "3.0", false},
+			{"\U0001f937\U0001f3ff", "person shrugging: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "3.0", false},
+			{"\U0001f937\u200d\u2642\ufe0f", "man shrugging", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f937\U0001f3fb\u200d\u2642\ufe0f", "man shrugging: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f937\U0001f3fc\u200d\u2642\ufe0f", "man shrugging: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f937\U0001f3fd\u200d\u2642\ufe0f", "man shrugging: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f937\U0001f3fe\u200d\u2642\ufe0f", "man shrugging: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f937\U0001f3ff\u200d\u2642\ufe0f", "man shrugging: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f937\u200d\u2640\ufe0f", "woman shrugging", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", true},
+			{"\U0001f937\U0001f3fb\u200d\u2640\ufe0f", "woman shrugging: light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f937\U0001f3fc\u200d\u2640\ufe0f", "woman shrugging: medium-light skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f937\U0001f3fd\u200d\u2640\ufe0f", "woman shrugging: medium skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f937\U0001f3fe\u200d\u2640\ufe0f", "woman shrugging: medium-dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f937\U0001f3ff\u200d\u2640\ufe0f", "woman shrugging: dark skin tone", "People & Body", "person-gesture", []string{"gesture", "person"}, "4.0", false},
+			{"\U0001f9d1\u200d\u2695\ufe0f", "health worker", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\u2695\ufe0f", "health worker: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\u2695\ufe0f", "health worker: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\u2695\ufe0f", "health worker: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\u2695\ufe0f", "health worker: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\u2695\ufe0f", "health worker: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\u2695\ufe0f", "man health worker", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\u2695\ufe0f", "man health worker: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\u2695\ufe0f", "man health worker: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\u2695\ufe0f", "man health worker: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\u2695\ufe0f", "man health worker: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\u2695\ufe0f", "man health worker: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\u2695\ufe0f", "woman health worker", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\u2695\ufe0f", "woman health worker: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\u2695\ufe0f", "woman health worker: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\u2695\ufe0f", "woman health worker: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\u2695\ufe0f", "woman health worker: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\u2695\ufe0f", "woman health worker: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\U0001f393", "student", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f393", "student: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f393", "student: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f393", "student: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f393", "student: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f393", "student: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\U0001f393", "man student", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f393", "man student: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f393", "man student: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f393", "man student: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f393", "man student: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f393", "man student: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f393", "woman student", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f393", "woman student: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f393", "woman student: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f393", "woman student: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f393", "woman student: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f393", "woman student: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\U0001f3eb", "teacher", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f3eb", "teacher: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f3eb", "teacher: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f3eb", "teacher: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f3eb", "teacher: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f3eb", "teacher: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\U0001f3eb", "man teacher", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f3eb", "man teacher: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f3eb", "man teacher: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f3eb", "man teacher: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f3eb", "man teacher: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f3eb", "man teacher: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f3eb", "woman teacher", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f3eb", "woman teacher: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f3eb", "woman teacher: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f3eb", "woman teacher: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f3eb", "woman teacher: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f3eb", "woman teacher: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\u2696\ufe0f", "judge", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\u2696\ufe0f", "judge: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\u2696\ufe0f", "judge: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\u2696\ufe0f", "judge: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\u2696\ufe0f", "judge: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\u2696\ufe0f", "judge: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\u2696\ufe0f", "man judge", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\u2696\ufe0f", "man judge: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\u2696\ufe0f", "man judge: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\u2696\ufe0f", "man judge: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\u2696\ufe0f", "man judge: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\u2696\ufe0f", "man judge: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\u2696\ufe0f", "woman judge", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\u2696\ufe0f", "woman judge: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\u2696\ufe0f", "woman judge: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\u2696\ufe0f", "woman judge: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\u2696\ufe0f", "woman judge: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\u2696\ufe0f", "woman judge: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\U0001f33e", "farmer", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f33e", "farmer: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f33e", "farmer: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f33e", "farmer: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f33e", "farmer: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f33e", "farmer: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\U0001f33e", "man farmer", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f33e", "man farmer: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f33e", "man farmer: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f33e", "man farmer: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f33e", "man farmer: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f33e", "man farmer: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f33e", "woman farmer", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f33e", "woman farmer: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f33e", "woman farmer: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f33e", "woman farmer: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f33e", "woman farmer: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f33e", "woman farmer: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\U0001f373", "cook", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f373", "cook: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f373", "cook: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f373", "cook: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f373", "cook: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f373", "cook: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\U0001f373", "man cook", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f373", "man cook: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f373", "man cook: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f373", "man cook: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f373", "man cook: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f373", "man cook: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f373", "woman cook", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f373", "woman cook: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f373", "woman cook: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f373", "woman cook: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f373", "woman cook: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f373", "woman cook: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\U0001f527", "mechanic", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f527", "mechanic: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f527", "mechanic: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f527", "mechanic: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f527", "mechanic: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f527", "mechanic: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\U0001f527", "man mechanic", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f527", "man mechanic: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f527", "man mechanic: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f527", "man mechanic: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f527", "man mechanic: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f527", "man mechanic: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f527", "woman mechanic", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f527", "woman mechanic: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f527", "woman mechanic: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f527", "woman mechanic: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f527", "woman mechanic: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f527", "woman mechanic: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\U0001f3ed", "factory worker", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f3ed", "factory worker: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f3ed", "factory worker: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f3ed", "factory worker: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f3ed", "factory worker: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f3ed", "factory worker: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\U0001f3ed", "man factory worker", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f3ed", "man factory worker: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f3ed", "man factory worker: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f3ed", "man factory worker: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f3ed", "man factory worker: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f3ed", "man factory worker: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f3ed", "woman factory worker", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f3ed", "woman factory worker: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f3ed", "woman factory worker: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f3ed", "woman factory worker: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f3ed", "woman factory worker: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f3ed", "woman factory worker: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\U0001f4bc", "office worker", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f4bc", "office worker: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f4bc", "office worker: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f4bc", "office worker: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f4bc", "office worker: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f4bc", "office worker: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\U0001f4bc", "man office worker", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f4bc", "man office worker: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f4bc", "man office worker: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f4bc", "man office worker: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f4bc", "man office worker: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f4bc", "man office worker: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f4bc", "woman office worker", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f4bc", "woman office worker: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f4bc", "woman office worker: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f4bc", "woman office worker: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f4bc", "woman office worker: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f4bc", "woman office worker: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\U0001f52c", "scientist", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f52c", "scientist: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f52c", "scientist: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f52c", "scientist: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f52c", "scientist: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f52c", "scientist: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\U0001f52c", "man scientist", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f52c", "man scientist: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f52c", "man scientist: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f52c", "man scientist: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f52c", "man scientist: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f52c", "man scientist: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f52c", "woman scientist", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f52c", "woman scientist: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f52c", "woman scientist: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f52c", "woman scientist: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f52c", "woman scientist: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f52c", "woman scientist: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\U0001f4bb", "technologist", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f4bb", "technologist: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f4bb", "technologist: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f4bb", "technologist: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f4bb", "technologist: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f4bb", "technologist: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\U0001f4bb", "man technologist", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f4bb", "man technologist: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f4bb", "man technologist: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f4bb", "man technologist: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f4bb", "man technologist: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f4bb", "man technologist: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f4bb", "woman technologist", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f4bb", "woman technologist: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f4bb", "woman technologist: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f4bb", "woman technologist: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f4bb", "woman technologist: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f4bb", "woman technologist: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\U0001f3a4", "singer", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f3a4", "singer: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f3a4", "singer: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f3a4", "singer: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f3a4", "singer: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f3a4", "singer: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\U0001f3a4", "man singer", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f3a4", "man singer: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f3a4", "man singer: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f3a4", "man singer: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f3a4", "man singer: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f3a4", "man singer: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f3a4", "woman singer", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f3a4", "woman singer: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f3a4", "woman singer: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f3a4", "woman singer: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f3a4", "woman singer: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f3a4", "woman singer: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\U0001f3a8", "artist", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f3a8", "artist: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f3a8", "artist: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f3a8", "artist: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f3a8", "artist: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f3a8", "artist: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\U0001f3a8", "man artist", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f3a8", "man artist: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f3a8", "man artist: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f3a8", "man artist: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f3a8", "man artist: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f3a8", "man artist: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f3a8", "woman artist", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f3a8", "woman artist: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f3a8", "woman artist: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f3a8", "woman artist: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f3a8", "woman artist: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f3a8", "woman artist: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\u2708\ufe0f", "pilot", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\u2708\ufe0f", "pilot: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\u2708\ufe0f", "pilot: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\u2708\ufe0f", "pilot: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\u2708\ufe0f", "pilot: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\u2708\ufe0f", "pilot: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\u2708\ufe0f", "man pilot", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\u2708\ufe0f", "man pilot: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\u2708\ufe0f", "man pilot: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\u2708\ufe0f", "man pilot: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\u2708\ufe0f", "man pilot: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\u2708\ufe0f", "man pilot: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\u2708\ufe0f", "woman pilot", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\u2708\ufe0f", "woman pilot: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\u2708\ufe0f", "woman pilot: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\u2708\ufe0f", "woman pilot: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\u2708\ufe0f", "woman pilot: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\u2708\ufe0f", "woman pilot: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\U0001f680", "astronaut", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f680", "astronaut: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f680", "astronaut: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f680", "astronaut: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f680", "astronaut: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f680", "astronaut: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\U0001f680", "man astronaut", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f680", "man astronaut: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f680", "man astronaut: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f680", "man astronaut: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f680", "man astronaut: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f680", "man astronaut: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f680", "woman astronaut", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f680", "woman astronaut: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f680", "woman astronaut: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f680", "woman astronaut: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f680", "woman astronaut: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f680", "woman astronaut: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f9d1\u200d\U0001f692", "firefighter", "People & Body", "person-role", []string{"person", "role"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f692", "firefighter: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f692", "firefighter: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f692", "firefighter: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f692", "firefighter: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f692", "firefighter: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "12.1", false},
+			{"\U0001f468\u200d\U0001f692", "man firefighter", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f692", "man firefighter: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f692", "man firefighter: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f692", "man firefighter: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f692", "man firefighter: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f692", "man firefighter: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f692", "woman firefighter", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f692", "woman firefighter: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f692", "woman firefighter: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f692", "woman firefighter: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f692", "woman firefighter: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f692", "woman firefighter: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f46e", "police officer", "People & Body", "person-role", []string{"person", "role"}, "0.6", true},
+			{"\U0001f46e\U0001f3fb", "police officer: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f46e\U0001f3fc", "police officer: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f46e\U0001f3fd", "police officer: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f46e\U0001f3fe", "police officer: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f46e\U0001f3ff", "police officer: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f46e\u200d\u2642\ufe0f", "man police officer", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f46e\U0001f3fb\u200d\u2642\ufe0f", "man police officer: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f46e\U0001f3fc\u200d\u2642\ufe0f", "man police officer: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f46e\U0001f3fd\u200d\u2642\ufe0f", "man police officer: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f46e\U0001f3fe\u200d\u2642\ufe0f", "man police officer: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f46e\U0001f3ff\u200d\u2642\ufe0f", "man police officer: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f46e\u200d\u2640\ufe0f", "woman police officer", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f46e\U0001f3fb\u200d\u2640\ufe0f", "woman police officer: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f46e\U0001f3fc\u200d\u2640\ufe0f", "woman police officer: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f46e\U0001f3fd\u200d\u2640\ufe0f", "woman police officer: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f46e\U0001f3fe\u200d\u2640\ufe0f", "woman police officer: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f46e\U0001f3ff\u200d\u2640\ufe0f", "woman police officer: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f575\ufe0f", "detective", "People & Body", "person-role", []string{"person", "role"}, "0.7", true},
+			{"\U0001f575\U0001f3fb", "detective: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "2.0", false},
+			{"\U0001f575\U0001f3fc", "detective: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "2.0", false},
+			{"\U0001f575\U0001f3fd", "detective: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "2.0", false},
+			{"\U0001f575\U0001f3fe", "detective: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "2.0", false},
+			{"\U0001f575\U0001f3ff", "detective: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "2.0", false},
+			{"\U0001f575\ufe0f\u200d\u2642\ufe0f", "man detective", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f575\U0001f3fb\u200d\u2642\ufe0f", "man detective: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f575\U0001f3fc\u200d\u2642\ufe0f", "man detective: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f575\U0001f3fd\u200d\u2642\ufe0f", "man detective: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f575\U0001f3fe\u200d\u2642\ufe0f", "man detective: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f575\U0001f3ff\u200d\u2642\ufe0f", "man detective: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f575\ufe0f\u200d\u2640\ufe0f", "woman detective", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f575\U0001f3fb\u200d\u2640\ufe0f", "woman detective: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f575\U0001f3fc\u200d\u2640\ufe0f", "woman detective: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f575\U0001f3fd\u200d\u2640\ufe0f", "woman detective: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f575\U0001f3fe\u200d\u2640\ufe0f", "woman detective: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f575\U0001f3ff\u200d\u2640\ufe0f", "woman detective: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f482", "guard", "People & Body", "person-role", []string{"person", "role"}, "0.6", true},
+			{"\U0001f482\U0001f3fb", "guard: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f482\U0001f3fc", "guard: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f482\U0001f3fd", "guard: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f482\U0001f3fe", "guard: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f482\U0001f3ff", "guard: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f482\u200d\u2642\ufe0f", "man guard", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f482\U0001f3fb\u200d\u2642\ufe0f", "man guard: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f482\U0001f3fc\u200d\u2642\ufe0f", "man guard: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f482\U0001f3fd\u200d\u2642\ufe0f", "man guard: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f482\U0001f3fe\u200d\u2642\ufe0f", "man guard: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f482\U0001f3ff\u200d\u2642\ufe0f", "man guard: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f482\u200d\u2640\ufe0f", "woman guard", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f482\U0001f3fb\u200d\u2640\ufe0f", "woman guard: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f482\U0001f3fc\u200d\u2640\ufe0f", "woman guard: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f482\U0001f3fd\u200d\u2640\ufe0f", "woman guard: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f482\U0001f3fe\u200d\u2640\ufe0f", "woman guard: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f482\U0001f3ff\u200d\u2640\ufe0f", "woman guard: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f977", "ninja", "People & Body", "person-role", []string{"person", "role"}, "13.0", true},
+			{"\U0001f977\U0001f3fb", "ninja: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f977\U0001f3fc", "ninja: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f977\U0001f3fd", "ninja: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f977\U0001f3fe", "ninja: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f977\U0001f3ff", "ninja: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f477", "construction worker", "People & Body", "person-role", []string{"person", "role"}, "0.6", true},
+			{"\U0001f477\U0001f3fb", "construction worker: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f477\U0001f3fc", "construction worker: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f477\U0001f3fd", "construction worker: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f477\U0001f3fe", "construction worker: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f477\U0001f3ff", "construction worker: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f477\u200d\u2642\ufe0f", "man construction worker", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f477\U0001f3fb\u200d\u2642\ufe0f", "man construction worker: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f477\U0001f3fc\u200d\u2642\ufe0f", "man construction worker: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f477\U0001f3fd\u200d\u2642\ufe0f", "man construction worker: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f477\U0001f3fe\u200d\u2642\ufe0f", "man construction worker: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f477\U0001f3ff\u200d\u2642\ufe0f", "man construction worker: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f477\u200d\u2640\ufe0f", "woman construction worker", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f477\U0001f3fb\u200d\u2640\ufe0f", "woman construction worker: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f477\U0001f3fc\u200d\u2640\ufe0f", "woman construction worker: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f477\U0001f3fd\u200d\u2640\ufe0f", "woman construction worker: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f477\U0001f3fe\u200d\u2640\ufe0f", "woman construction worker: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f477\U0001f3ff\u200d\u2640\ufe0f", "woman construction worker: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001fac5", "person with crown", "People & Body", "person-role", []string{"person", "role"}, "14.0", true},
+			{"\U0001fac5\U0001f3fb", "person with crown: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001fac5\U0001f3fc", "person with crown: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001fac5\U0001f3fd", "person with crown: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001fac5\U0001f3fe", "person with crown: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001fac5\U0001f3ff", "person with crown: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001f934", "prince", "People & Body", "person-role", []string{"person", "role"}, "3.0", true},
+			{"\U0001f934\U0001f3fb", "prince: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001f934\U0001f3fc", "prince: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001f934\U0001f3fd", "prince: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001f934\U0001f3fe", "prince: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001f934\U0001f3ff", "prince: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001f478", "princess", "People & Body", "person-role", []string{"person", "role"}, "0.6", true},
+			{"\U0001f478\U0001f3fb", "princess: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f478\U0001f3fc", "princess: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f478\U0001f3fd", "princess: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f478\U0001f3fe", "princess: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f478\U0001f3ff", "princess: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f473", "person wearing turban", "People & Body", "person-role", []string{"person", "role"}, "0.6", true},
+			{"\U0001f473\U0001f3fb", "person wearing turban: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f473\U0001f3fc", "person wearing turban: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f473\U0001f3fd", "person wearing turban: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f473\U0001f3fe", "person wearing turban: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f473\U0001f3ff", "person wearing turban: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f473\u200d\u2642\ufe0f", "man wearing turban", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f473\U0001f3fb\u200d\u2642\ufe0f", "man wearing turban: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f473\U0001f3fc\u200d\u2642\ufe0f", "man wearing turban: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f473\U0001f3fd\u200d\u2642\ufe0f", "man wearing turban: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f473\U0001f3fe\u200d\u2642\ufe0f", "man wearing turban: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f473\U0001f3ff\u200d\u2642\ufe0f", "man wearing turban: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f473\u200d\u2640\ufe0f", "woman wearing turban", "People & Body", "person-role", []string{"person", "role"}, "4.0", true},
+			{"\U0001f473\U0001f3fb\u200d\u2640\ufe0f", "woman wearing turban: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f473\U0001f3fc\u200d\u2640\ufe0f", "woman wearing turban: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f473\U0001f3fd\u200d\u2640\ufe0f", "woman wearing turban: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f473\U0001f3fe\u200d\u2640\ufe0f", "woman wearing turban: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f473\U0001f3ff\u200d\u2640\ufe0f", "woman wearing turban: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "4.0", false},
+			{"\U0001f472", "person with skullcap", "People & Body", "person-role", []string{"person", "role"}, "0.6", true},
+			{"\U0001f472\U0001f3fb", "person with skullcap: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f472\U0001f3fc", "person with skullcap: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f472\U0001f3fd", "person with skullcap: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f472\U0001f3fe", "person with skullcap: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f472\U0001f3ff", "person with skullcap: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f9d5", "woman with headscarf", "People & Body", "person-role", []string{"person", "role"}, "5.0", true},
+			{"\U0001f9d5\U0001f3fb", "woman with headscarf: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "5.0", false},
+			{"\U0001f9d5\U0001f3fc", "woman with headscarf: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "5.0", false},
+			{"\U0001f9d5\U0001f3fd", "woman with headscarf: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "5.0", false},
+			{"\U0001f9d5\U0001f3fe", "woman with headscarf: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "5.0", false},
+			{"\U0001f9d5\U0001f3ff", "woman with headscarf: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "5.0", false},
+			{"\U0001f935", "person in tuxedo", "People & Body", "person-role", []string{"person", "role"}, "3.0", true},
+			{"\U0001f935\U0001f3fb", "person in tuxedo: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001f935\U0001f3fc", "person in tuxedo: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001f935\U0001f3fd", "person in tuxedo: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001f935\U0001f3fe", "person in tuxedo: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001f935\U0001f3ff", "person in tuxedo: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001f935\u200d\u2642\ufe0f", "man in tuxedo", "People & Body", "person-role", []string{"person", "role"}, "13.0", true},
+			{"\U0001f935\U0001f3fb\u200d\u2642\ufe0f", "man in tuxedo: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f935\U0001f3fc\u200d\u2642\ufe0f", "man in tuxedo: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f935\U0001f3fd\u200d\u2642\ufe0f", "man in tuxedo: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f935\U0001f3fe\u200d\u2642\ufe0f", "man in tuxedo: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f935\U0001f3ff\u200d\u2642\ufe0f", "man in tuxedo: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f935\u200d\u2640\ufe0f", "woman in tuxedo", "People & Body", "person-role", []string{"person", "role"}, "13.0", true},
+			{"\U0001f935\U0001f3fb\u200d\u2640\ufe0f", "woman in tuxedo: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f935\U0001f3fc\u200d\u2640\ufe0f", "woman in tuxedo: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f935\U0001f3fd\u200d\u2640\ufe0f", "woman in tuxedo: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f935\U0001f3fe\u200d\u2640\ufe0f", "woman in tuxedo: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f935\U0001f3ff\u200d\u2640\ufe0f", "woman in tuxedo: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f470", "person with veil", "People & Body", "person-role", []string{"person", "role"}, "0.6", true},
+			{"\U0001f470\U0001f3fb", "person with veil: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f470\U0001f3fc", "person with veil: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f470\U0001f3fd", "person with veil: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f470\U0001f3fe", "person with veil: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f470\U0001f3ff", "person with veil: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "1.0", false},
+			{"\U0001f470\u200d\u2642\ufe0f", "man with veil", "People & Body", "person-role", []string{"person", "role"}, "13.0", true},
+			{"\U0001f470\U0001f3fb\u200d\u2642\ufe0f", "man with veil: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f470\U0001f3fc\u200d\u2642\ufe0f", "man with veil: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f470\U0001f3fd\u200d\u2642\ufe0f", "man with veil: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f470\U0001f3fe\u200d\u2642\ufe0f", "man with veil: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f470\U0001f3ff\u200d\u2642\ufe0f", "man with veil: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f470\u200d\u2640\ufe0f", "woman with veil", "People & Body", "person-role", []string{"person", "role"}, "13.0", true},
+			{"\U0001f470\U0001f3fb\u200d\u2640\ufe0f", "woman with veil: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f470\U0001f3fc\u200d\u2640\ufe0f", "woman with veil: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f470\U0001f3fd\u200d\u2640\ufe0f", "woman with veil: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f470\U0001f3fe\u200d\u2640\ufe0f", "woman with veil: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f470\U0001f3ff\u200d\u2640\ufe0f", "woman with veil: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f930", "pregnant woman", "People & Body", "person-role", []string{"person", "role"}, "3.0", true},
+			{"\U0001f930\U0001f3fb", "pregnant woman: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001f930\U0001f3fc", "pregnant woman: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001f930\U0001f3fd", "pregnant woman: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001f930\U0001f3fe", "pregnant woman: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001f930\U0001f3ff", "pregnant woman: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "3.0", false},
+			{"\U0001fac3", "pregnant man", "People & Body", "person-role", []string{"person", "role"}, "14.0", true},
+			{"\U0001fac3\U0001f3fb", "pregnant man: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001fac3\U0001f3fc", "pregnant man: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001fac3\U0001f3fd", "pregnant man: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001fac3\U0001f3fe", "pregnant man: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001fac3\U0001f3ff", "pregnant man: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001fac4", "pregnant person", "People & Body", "person-role", []string{"person", "role"}, "14.0", true},
+			{"\U0001fac4\U0001f3fb", "pregnant person: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001fac4\U0001f3fc", "pregnant person: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001fac4\U0001f3fd", "pregnant person: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001fac4\U0001f3fe", "pregnant person: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001fac4\U0001f3ff", "pregnant person: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "14.0", false},
+			{"\U0001f931", "breast-feeding", "People & Body", "person-role", []string{"person", "role"}, "5.0", true},
+			{"\U0001f931\U0001f3fb", "breast-feeding: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "5.0", false},
+			{"\U0001f931\U0001f3fc", "breast-feeding: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "5.0", false},
+			{"\U0001f931\U0001f3fd", "breast-feeding: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "5.0", false},
+			{"\U0001f931\U0001f3fe", "breast-feeding: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "5.0", false},
+			{"\U0001f931\U0001f3ff", "breast-feeding: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "5.0", false},
+			{"\U0001f469\u200d\U0001f37c", "woman feeding baby", "People & Body", "person-role", []string{"person", "role"}, "13.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f37c", "woman feeding baby: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f37c", "woman feeding baby: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f37c", "woman feeding baby: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f37c", "woman feeding baby: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f37c", "woman feeding baby: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f468\u200d\U0001f37c", "man feeding baby", "People & Body", "person-role", []string{"person", "role"}, "13.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f37c", "man feeding baby: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f37c", "man feeding baby: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f37c", "man feeding baby: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f37c", "man feeding baby: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f37c", "man feeding baby: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f9d1\u200d\U0001f37c", "person feeding baby", "People & Body", "person-role", []string{"person", "role"}, "13.0", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f37c", "person feeding baby: light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f37c", "person feeding baby: medium-light skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f37c", "person feeding baby: medium skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f37c", "person feeding baby: medium-dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f37c", "person feeding baby: dark skin tone", "People & Body", "person-role", []string{"person", "role"}, "13.0", false},
+			{"\U0001f47c", "baby angel", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "0.6", true},
+			{"\U0001f47c\U0001f3fb", "baby angel: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "1.0", false},
+			{"\U0001f47c\U0001f3fc", "baby angel: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "1.0", false},
+			{"\U0001f47c\U0001f3fd", "baby angel: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "1.0", false},
+			{"\U0001f47c\U0001f3fe", "baby angel: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "1.0", false},
+			{"\U0001f47c\U0001f3ff", "baby angel: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "1.0", false},
+			{"\U0001f385", "Santa Claus", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "0.6", true},
+			{"\U0001f385\U0001f3fb", "Santa Claus: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "1.0", false},
+			{"\U0001f385\U0001f3fc", "Santa Claus: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "1.0", false},
+			{"\U0001f385\U0001f3fd", "Santa Claus: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "1.0", false},
+			{"\U0001f385\U0001f3fe", "Santa Claus: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "1.0", false},
+			{"\U0001f385\U0001f3ff", "Santa Claus: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "1.0", false},
+			{"\U0001f936", "Mrs. Claus", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "3.0", true},
+			{"\U0001f936\U0001f3fb", "Mrs. Claus: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "3.0", false},
+			{"\U0001f936\U0001f3fc", "Mrs. Claus: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "3.0", false},
+			{"\U0001f936\U0001f3fd", "Mrs. Claus: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "3.0", false},
+			{"\U0001f936\U0001f3fe", "Mrs. Claus: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "3.0", false},
+			{"\U0001f936\U0001f3ff", "Mrs. Claus: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "3.0", false},
+			{"\U0001f9d1\u200d\U0001f384", "mx claus", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "13.0", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f384", "mx claus: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "13.0", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f384", "mx claus: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "13.0", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f384", "mx claus: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "13.0", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f384", "mx claus: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "13.0", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f384", "mx claus: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "13.0", false},
+			{"\U0001f9b8", "superhero", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", true},
+			{"\U0001f9b8\U0001f3fb", "superhero: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b8\U0001f3fc", "superhero: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b8\U0001f3fd", "superhero: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b8\U0001f3fe", "superhero: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b8\U0001f3ff", "superhero: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b8\u200d\u2642\ufe0f", "man superhero", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", true},
+			{"\U0001f9b8\U0001f3fb\u200d\u2642\ufe0f", "man superhero: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b8\U0001f3fc\u200d\u2642\ufe0f", "man superhero: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b8\U0001f3fd\u200d\u2642\ufe0f", "man superhero: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b8\U0001f3fe\u200d\u2642\ufe0f", "man superhero: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b8\U0001f3ff\u200d\u2642\ufe0f", "man superhero: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b8\u200d\u2640\ufe0f", "woman superhero", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", true},
+			{"\U0001f9b8\U0001f3fb\u200d\u2640\ufe0f", "woman superhero: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b8\U0001f3fc\u200d\u2640\ufe0f", "woman superhero: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b8\U0001f3fd\u200d\u2640\ufe0f", "woman superhero: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b8\U0001f3fe\u200d\u2640\ufe0f", "woman superhero: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b8\U0001f3ff\u200d\u2640\ufe0f", "woman superhero: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9", "supervillain", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", true},
+			{"\U0001f9b9\U0001f3fb", "supervillain: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9\U0001f3fc", "supervillain: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9\U0001f3fd", "supervillain: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9\U0001f3fe", "supervillain: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9\U0001f3ff", "supervillain: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9\u200d\u2642\ufe0f", "man supervillain", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", true},
+			{"\U0001f9b9\U0001f3fb\u200d\u2642\ufe0f", "man supervillain: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9\U0001f3fc\u200d\u2642\ufe0f", "man supervillain: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9\U0001f3fd\u200d\u2642\ufe0f", "man supervillain: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9\U0001f3fe\u200d\u2642\ufe0f", "man supervillain: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9\U0001f3ff\u200d\u2642\ufe0f", "man supervillain: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9\u200d\u2640\ufe0f", "woman supervillain", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", true},
+			{"\U0001f9b9\U0001f3fb\u200d\u2640\ufe0f", "woman supervillain: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9\U0001f3fc\u200d\u2640\ufe0f", "woman supervillain: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9\U0001f3fd\u200d\u2640\ufe0f", "woman supervillain: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9\U0001f3fe\u200d\u2640\ufe0f", "woman supervillain: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9b9\U0001f3ff\u200d\u2640\ufe0f", "woman supervillain: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "11.0", false},
+			{"\U0001f9d9", "mage", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9d9\U0001f3fb", "mage: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9d9\U0001f3fc", "mage: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9d9\U0001f3fd", "mage: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9d9\U0001f3fe", "mage: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9d9\U0001f3ff", "mage: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9d9\u200d\u2642\ufe0f", "man mage", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9d9\U0001f3fb\u200d\u2642\ufe0f", "man mage: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9d9\U0001f3fc\u200d\u2642\ufe0f", "man mage: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9d9\U0001f3fd\u200d\u2642\ufe0f", "man mage: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9d9\U0001f3fe\u200d\u2642\ufe0f", "man mage: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9d9\U0001f3ff\u200d\u2642\ufe0f", "man mage: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9d9\u200d\u2640\ufe0f", "woman mage", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9d9\U0001f3fb\u200d\u2640\ufe0f", "woman mage: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9d9\U0001f3fc\u200d\u2640\ufe0f", "woman mage: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9d9\U0001f3fd\u200d\u2640\ufe0f", "woman mage: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9d9\U0001f3fe\u200d\u2640\ufe0f", "woman mage: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9d9\U0001f3ff\u200d\u2640\ufe0f", "woman mage: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da", "fairy", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9da\U0001f3fb", "fairy: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da\U0001f3fc", "fairy: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da\U0001f3fd", "fairy: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da\U0001f3fe", "fairy: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da\U0001f3ff", "fairy: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da\u200d\u2642\ufe0f", "man fairy", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9da\U0001f3fb\u200d\u2642\ufe0f", "man fairy: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da\U0001f3fc\u200d\u2642\ufe0f", "man fairy: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da\U0001f3fd\u200d\u2642\ufe0f", "man fairy: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da\U0001f3fe\u200d\u2642\ufe0f", "man fairy: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da\U0001f3ff\u200d\u2642\ufe0f", "man fairy: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da\u200d\u2640\ufe0f", "woman fairy", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9da\U0001f3fb\u200d\u2640\ufe0f", "woman fairy: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da\U0001f3fc\u200d\u2640\ufe0f", "woman fairy: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da\U0001f3fd\u200d\u2640\ufe0f", "woman fairy: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da\U0001f3fe\u200d\u2640\ufe0f", "woman fairy: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9da\U0001f3ff\u200d\u2640\ufe0f", "woman fairy: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db", "vampire", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9db\U0001f3fb", "vampire: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db\U0001f3fc", "vampire: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db\U0001f3fd", "vampire: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db\U0001f3fe", "vampire: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db\U0001f3ff", "vampire: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db\u200d\u2642\ufe0f", "man vampire", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9db\U0001f3fb\u200d\u2642\ufe0f", "man vampire: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db\U0001f3fc\u200d\u2642\ufe0f", "man vampire: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db\U0001f3fd\u200d\u2642\ufe0f", "man vampire: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db\U0001f3fe\u200d\u2642\ufe0f", "man vampire: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db\U0001f3ff\u200d\u2642\ufe0f", "man vampire: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db\u200d\u2640\ufe0f", "woman vampire", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9db\U0001f3fb\u200d\u2640\ufe0f", "woman vampire: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db\U0001f3fc\u200d\u2640\ufe0f", "woman vampire: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db\U0001f3fd\u200d\u2640\ufe0f", "woman vampire: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db\U0001f3fe\u200d\u2640\ufe0f", "woman vampire: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9db\U0001f3ff\u200d\u2640\ufe0f", "woman vampire: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc", "merperson", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9dc\U0001f3fb", "merperson: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc\U0001f3fc", "merperson: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc\U0001f3fd", "merperson: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc\U0001f3fe", "merperson: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc\U0001f3ff", "merperson: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc\u200d\u2642\ufe0f", "merman", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9dc\U0001f3fb\u200d\u2642\ufe0f", "merman: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc\U0001f3fc\u200d\u2642\ufe0f", "merman: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc\U0001f3fd\u200d\u2642\ufe0f", "merman: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc\U0001f3fe\u200d\u2642\ufe0f", "merman: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc\U0001f3ff\u200d\u2642\ufe0f", "merman: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc\u200d\u2640\ufe0f", "mermaid", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9dc\U0001f3fb\u200d\u2640\ufe0f", "mermaid: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc\U0001f3fc\u200d\u2640\ufe0f", "mermaid: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc\U0001f3fd\u200d\u2640\ufe0f", "mermaid: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc\U0001f3fe\u200d\u2640\ufe0f", "mermaid: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dc\U0001f3ff\u200d\u2640\ufe0f", "mermaid: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd", "elf", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9dd\U0001f3fb", "elf: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd\U0001f3fc", "elf: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd\U0001f3fd", "elf: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd\U0001f3fe", "elf: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd\U0001f3ff", "elf: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd\u200d\u2642\ufe0f", "man elf", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9dd\U0001f3fb\u200d\u2642\ufe0f", "man elf: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd\U0001f3fc\u200d\u2642\ufe0f", "man elf: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd\U0001f3fd\u200d\u2642\ufe0f", "man elf: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd\U0001f3fe\u200d\u2642\ufe0f", "man elf: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd\U0001f3ff\u200d\u2642\ufe0f", "man elf: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd\u200d\u2640\ufe0f", "woman elf", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", true},
+			{"\U0001f9dd\U0001f3fb\u200d\u2640\ufe0f", "woman elf: light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd\U0001f3fc\u200d\u2640\ufe0f", "woman elf: medium-light skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd\U0001f3fd\u200d\u2640\ufe0f", "woman elf: medium skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd\U0001f3fe\u200d\u2640\ufe0f", "woman elf: medium-dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9dd\U0001f3ff\u200d\u2640\ufe0f", "woman elf: dark skin tone", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9de", "genie", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9de\u200d\u2642\ufe0f", "man genie", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9de\u200d\u2640\ufe0f", "woman genie", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9df", "zombie", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9df\u200d\u2642\ufe0f", "man zombie", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9df\u200d\u2640\ufe0f", "woman zombie", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "5.0", false},
+			{"\U0001f9cc", "troll", "People & Body", "person-fantasy", []string{"fantasy", "person"}, "14.0", false},
+			{"\U0001f486", "person getting massage", "People & Body", "person-activity", []string{"activity", "person"}, "0.6", true},
+			{"\U0001f486\U0001f3fb", "person getting massage: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f486\U0001f3fc", "person getting massage: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f486\U0001f3fd", "person getting massage: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f486\U0001f3fe", "person getting massage: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f486\U0001f3ff", "person getting massage: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f486\u200d\u2642\ufe0f", "man getting massage", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", true},
+			{"\U0001f486\U0001f3fb\u200d\u2642\ufe0f", "man getting massage: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f486\U0001f3fc\u200d\u2642\ufe0f", "man getting massage: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f486\U0001f3fd\u200d\u2642\ufe0f", "man getting massage: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f486\U0001f3fe\u200d\u2642\ufe0f", "man getting massage: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f486\U0001f3ff\u200d\u2642\ufe0f", "man getting massage: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f486\u200d\u2640\ufe0f", "woman getting massage", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", true},
+			{"\U0001f486\U0001f3fb\u200d\u2640\ufe0f", "woman getting massage: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f486\U0001f3fc\u200d\u2640\ufe0f", "woman getting massage: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f486\U0001f3fd\u200d\u2640\ufe0f", "woman getting massage: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f486\U0001f3fe\u200d\u2640\ufe0f", "woman getting massage: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f486\U0001f3ff\u200d\u2640\ufe0f", "woman getting massage: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f487", "person getting haircut", "People & Body", "person-activity", []string{"activity", "person"}, "0.6", true},
+			{"\U0001f487\U0001f3fb", "person getting haircut: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f487\U0001f3fc", "person getting haircut: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f487\U0001f3fd", "person getting haircut: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f487\U0001f3fe", "person getting haircut: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f487\U0001f3ff", "person getting haircut: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f487\u200d\u2642\ufe0f", "man getting haircut", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", true},
+			{"\U0001f487\U0001f3fb\u200d\u2642\ufe0f", "man getting haircut: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f487\U0001f3fc\u200d\u2642\ufe0f", "man getting haircut: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f487\U0001f3fd\u200d\u2642\ufe0f", "man getting haircut: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f487\U0001f3fe\u200d\u2642\ufe0f", "man getting haircut: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f487\U0001f3ff\u200d\u2642\ufe0f", "man getting haircut: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f487\u200d\u2640\ufe0f", "woman getting haircut", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", true},
+			{"\U0001f487\U0001f3fb\u200d\u2640\ufe0f", "woman getting haircut: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f487\U0001f3fc\u200d\u2640\ufe0f", "woman getting haircut: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f487\U0001f3fd\u200d\u2640\ufe0f", "woman getting haircut: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f487\U0001f3fe\u200d\u2640\ufe0f", "woman getting haircut: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f487\U0001f3ff\u200d\u2640\ufe0f", "woman getting haircut: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f6b6", "person walking", "People & Body", "person-activity", []string{"activity", "person"}, "0.6", true},
+			{"\U0001f6b6\U0001f3fb", "person walking: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f6b6\U0001f3fc", "person walking: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f6b6\U0001f3fd", "person walking: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f6b6\U0001f3fe", "person walking: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f6b6\U0001f3ff", "person walking: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f6b6\u200d\u2642\ufe0f", "man walking", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", true},
+			{"\U0001f6b6\U0001f3fb\u200d\u2642\ufe0f", "man walking: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f6b6\U0001f3fc\u200d\u2642\ufe0f", "man walking: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f6b6\U0001f3fd\u200d\u2642\ufe0f", "man walking: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f6b6\U0001f3fe\u200d\u2642\ufe0f", "man walking: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f6b6\U0001f3ff\u200d\u2642\ufe0f", "man walking: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f6b6\u200d\u2640\ufe0f", "woman walking", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", true},
+			{"\U0001f6b6\U0001f3fb\u200d\u2640\ufe0f", "woman walking: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f6b6\U0001f3fc\u200d\u2640\ufe0f", "woman walking: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f6b6\U0001f3fd\u200d\u2640\ufe0f", "woman walking: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f6b6\U0001f3fe\u200d\u2640\ufe0f", "woman walking: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f6b6\U0001f3ff\u200d\u2640\ufe0f", "woman walking: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f6b6\u200d\u27a1\ufe0f", "person walking facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f6b6\U0001f3fb\u200d\u27a1\ufe0f", "person walking facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f6b6\U0001f3fc\u200d\u27a1\ufe0f", "person walking facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f6b6\U0001f3fd\u200d\u27a1\ufe0f", "person walking facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f6b6\U0001f3fe\u200d\u27a1\ufe0f", "person walking facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f6b6\U0001f3ff\u200d\u27a1\ufe0f", "person walking facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f6b6\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman walking facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f6b6\U0001f3fb\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman walking facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f6b6\U0001f3fc\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman walking facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f6b6\U0001f3fd\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman walking facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f6b6\U0001f3fe\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman walking facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f6b6\U0001f3ff\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman walking facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f6b6\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man walking facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f6b6\U0001f3fb\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man walking facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f6b6\U0001f3fc\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man walking facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f6b6\U0001f3fd\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man walking facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f6b6\U0001f3fe\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man walking facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f6b6\U0001f3ff\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man walking facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9cd", "person standing", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", true},
+			{"\U0001f9cd\U0001f3fb", "person standing: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9cd\U0001f3fc", "person standing: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9cd\U0001f3fd", "person standing: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9cd\U0001f3fe", "person standing: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9cd\U0001f3ff", "person standing: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9cd\u200d\u2642\ufe0f", "man standing", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", true},
+			{"\U0001f9cd\U0001f3fb\u200d\u2642\ufe0f", "man standing: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9cd\U0001f3fc\u200d\u2642\ufe0f", "man standing: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9cd\U0001f3fd\u200d\u2642\ufe0f", "man standing: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9cd\U0001f3fe\u200d\u2642\ufe0f", "man standing: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9cd\U0001f3ff\u200d\u2642\ufe0f", "man standing: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9cd\u200d\u2640\ufe0f", "woman standing", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", true},
+			{"\U0001f9cd\U0001f3fb\u200d\u2640\ufe0f", "woman standing: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9cd\U0001f3fc\u200d\u2640\ufe0f", "woman standing: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9cd\U0001f3fd\u200d\u2640\ufe0f", "woman standing: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9cd\U0001f3fe\u200d\u2640\ufe0f", "woman standing: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9cd\U0001f3ff\u200d\u2640\ufe0f", "woman standing: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce", "person kneeling", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", true},
+			{"\U0001f9ce\U0001f3fb", "person kneeling: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\U0001f3fc", "person kneeling: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\U0001f3fd", "person kneeling: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\U0001f3fe", "person kneeling: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\U0001f3ff", "person kneeling: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\u200d\u2642\ufe0f", "man kneeling", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", true},
+			{"\U0001f9ce\U0001f3fb\u200d\u2642\ufe0f", "man kneeling: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\U0001f3fc\u200d\u2642\ufe0f", "man kneeling: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\U0001f3fd\u200d\u2642\ufe0f", "man kneeling: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\U0001f3fe\u200d\u2642\ufe0f", "man kneeling: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\U0001f3ff\u200d\u2642\ufe0f", "man kneeling: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\u200d\u2640\ufe0f", "woman kneeling", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", true},
+			{"\U0001f9ce\U0001f3fb\u200d\u2640\ufe0f", "woman kneeling: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\U0001f3fc\u200d\u2640\ufe0f", "woman kneeling: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\U0001f3fd\u200d\u2640\ufe0f", "woman kneeling: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\U0001f3fe\u200d\u2640\ufe0f", "woman kneeling: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\U0001f3ff\u200d\u2640\ufe0f", "woman kneeling: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f9ce\u200d\u27a1\ufe0f", "person kneeling facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f9ce\U0001f3fb\u200d\u27a1\ufe0f", "person kneeling facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9ce\U0001f3fc\u200d\u27a1\ufe0f", "person kneeling facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9ce\U0001f3fd\u200d\u27a1\ufe0f", "person kneeling facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9ce\U0001f3fe\u200d\u27a1\ufe0f", "person kneeling facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9ce\U0001f3ff\u200d\u27a1\ufe0f", "person kneeling facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9ce\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman kneeling facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f9ce\U0001f3fb\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman kneeling facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9ce\U0001f3fc\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman kneeling facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9ce\U0001f3fd\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman kneeling facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9ce\U0001f3fe\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman kneeling facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9ce\U0001f3ff\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman kneeling facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9ce\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man kneeling facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f9ce\U0001f3fb\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man kneeling facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9ce\U0001f3fc\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man kneeling facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9ce\U0001f3fd\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man kneeling facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9ce\U0001f3fe\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man kneeling facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9ce\U0001f3ff\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man kneeling facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\u200d\U0001f9af", "person with white cane", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f9af", "person with white cane: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f9af", "person with white cane: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f9af", "person with white cane: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f9af", "person with white cane: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f9af", "person with white cane: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\u200d\U0001f9af\u200d\u27a1\ufe0f", "person with white cane facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f9af\u200d\u27a1\ufe0f", "person with white cane facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f9af\u200d\u27a1\ufe0f", "person with white cane facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f9af\u200d\u27a1\ufe0f", "person with white cane facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f9af\u200d\u27a1\ufe0f", "person with white cane facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f9af\u200d\u27a1\ufe0f", "person with white cane facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\u200d\U0001f9af", "man with white cane", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f9af", "man with white cane: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f9af", "man with white cane: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f9af", "man with white cane: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f9af", "man with white cane: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f9af", "man with white cane: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\u200d\U0001f9af\u200d\u27a1\ufe0f", "man with white cane facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f9af\u200d\u27a1\ufe0f", "man with white cane facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f9af\u200d\u27a1\ufe0f", "man with white cane facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f9af\u200d\u27a1\ufe0f", "man with white cane facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f9af\u200d\u27a1\ufe0f", "man with white cane facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f9af\u200d\u27a1\ufe0f", "man with white cane facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\u200d\U0001f9af", "woman with white cane", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f9af", "woman with white cane: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f9af", "woman with white cane: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f9af", "woman with white cane: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f9af", "woman with white cane: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f9af", "woman with white cane: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\u200d\U0001f9af\u200d\u27a1\ufe0f", "woman with white cane facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f9af\u200d\u27a1\ufe0f", "woman with white cane facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f9af\u200d\u27a1\ufe0f", "woman with white cane facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f9af\u200d\u27a1\ufe0f", "woman with white cane facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f9af\u200d\u27a1\ufe0f", "woman with white cane facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f9af\u200d\u27a1\ufe0f", "woman with white cane facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\u200d\U0001f9bc", "person in motorized wheelchair", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f9bc", "person in motorized wheelchair: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f9bc", "person in motorized wheelchair: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f9bc", "person in motorized wheelchair: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f9bc", "person in motorized wheelchair: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f9bc", "person in motorized wheelchair: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\u200d\U0001f9bc\u200d\u27a1\ufe0f", "person in motorized wheelchair facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f9bc\u200d\u27a1\ufe0f", "person in motorized wheelchair facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f9bc\u200d\u27a1\ufe0f", "person in motorized wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f9bc\u200d\u27a1\ufe0f", "person in motorized wheelchair facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f9bc\u200d\u27a1\ufe0f", "person in motorized wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f9bc\u200d\u27a1\ufe0f", "person in motorized wheelchair facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\u200d\U0001f9bc", "man in motorized wheelchair", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f9bc", "man in motorized wheelchair: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f9bc", "man in motorized wheelchair: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f9bc", "man in motorized wheelchair: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f9bc", "man in motorized wheelchair: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f9bc", "man in motorized wheelchair: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\u200d\U0001f9bc\u200d\u27a1\ufe0f", "man in motorized wheelchair facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f9bc\u200d\u27a1\ufe0f", "man in motorized wheelchair facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f9bc\u200d\u27a1\ufe0f", "man in motorized wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f9bc\u200d\u27a1\ufe0f", "man in motorized wheelchair facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f9bc\u200d\u27a1\ufe0f", "man in motorized wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f9bc\u200d\u27a1\ufe0f", "man in motorized wheelchair facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\u200d\U0001f9bc", "woman in motorized wheelchair", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f9bc", "woman in motorized wheelchair: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f9bc", "woman in motorized wheelchair: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f9bc", "woman in motorized wheelchair: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f9bc", "woman in motorized wheelchair: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f9bc", "woman in motorized wheelchair: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\u200d\U0001f9bc\u200d\u27a1\ufe0f", "woman in motorized wheelchair facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f9bc\u200d\u27a1\ufe0f", "woman in motorized wheelchair facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f9bc\u200d\u27a1\ufe0f", "woman in motorized wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f9bc\u200d\u27a1\ufe0f", "woman in motorized wheelchair facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f9bc\u200d\u27a1\ufe0f", "woman in motorized wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f9bc\u200d\u27a1\ufe0f", "woman in motorized wheelchair facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\u200d\U0001f9bd", "person in manual wheelchair", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f9bd", "person in manual wheelchair: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f9bd", "person in manual wheelchair: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f9bd", "person in manual wheelchair: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f9bd", "person in manual wheelchair: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f9bd", "person in manual wheelchair: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.1", false},
+			{"\U0001f9d1\u200d\U0001f9bd\u200d\u27a1\ufe0f", "person in manual wheelchair facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f9bd\u200d\u27a1\ufe0f", "person in manual wheelchair facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f9bd\u200d\u27a1\ufe0f", "person in manual wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f9bd\u200d\u27a1\ufe0f", "person in manual wheelchair facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f9bd\u200d\u27a1\ufe0f", "person in manual wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f9bd\u200d\u27a1\ufe0f", "person in manual wheelchair facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\u200d\U0001f9bd", "man in manual wheelchair", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f9bd", "man in manual wheelchair: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f9bd", "man in manual wheelchair: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f9bd", "man in manual wheelchair: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f9bd", "man in manual wheelchair: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f9bd", "man in manual wheelchair: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f468\u200d\U0001f9bd\u200d\u27a1\ufe0f", "man in manual wheelchair facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f468\U0001f3fb\u200d\U0001f9bd\u200d\u27a1\ufe0f", "man in manual wheelchair facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f9bd\u200d\u27a1\ufe0f", "man in manual wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f9bd\u200d\u27a1\ufe0f", "man in manual wheelchair facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f9bd\u200d\u27a1\ufe0f", "man in manual wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f9bd\u200d\u27a1\ufe0f", "man in manual wheelchair facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\u200d\U0001f9bd", "woman in manual wheelchair", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f9bd", "woman in manual wheelchair: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f9bd", "woman in manual wheelchair: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f9bd", "woman in manual wheelchair: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f9bd", "woman in manual wheelchair: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f9bd", "woman in manual wheelchair: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "12.0", false},
+			{"\U0001f469\u200d\U0001f9bd\u200d\u27a1\ufe0f", "woman in manual wheelchair facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f469\U0001f3fb\u200d\U0001f9bd\u200d\u27a1\ufe0f", "woman in manual wheelchair facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f9bd\u200d\u27a1\ufe0f", "woman in manual wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f9bd\u200d\u27a1\ufe0f", "woman in manual wheelchair facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f9bd\u200d\u27a1\ufe0f", "woman in manual wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f9bd\u200d\u27a1\ufe0f", "woman in manual wheelchair facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3", "person running", "People & Body", "person-activity", []string{"activity", "person"}, "0.6", true},
+			{"\U0001f3c3\U0001f3fb", "person running: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f3c3\U0001f3fc", "person running: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f3c3\U0001f3fd", "person running: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f3c3\U0001f3fe", "person running: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f3c3\U0001f3ff", "person running: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f3c3\u200d\u2642\ufe0f", "man running", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", true},
+			{"\U0001f3c3\U0001f3fb\u200d\u2642\ufe0f", "man running: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f3c3\U0001f3fc\u200d\u2642\ufe0f", "man running: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f3c3\U0001f3fd\u200d\u2642\ufe0f", "man running: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f3c3\U0001f3fe\u200d\u2642\ufe0f", "man running: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f3c3\U0001f3ff\u200d\u2642\ufe0f", "man running: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f3c3\u200d\u2640\ufe0f", "woman running", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", true},
+			{"\U0001f3c3\U0001f3fb\u200d\u2640\ufe0f", "woman running: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f3c3\U0001f3fc\u200d\u2640\ufe0f", "woman running: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f3c3\U0001f3fd\u200d\u2640\ufe0f", "woman running: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f3c3\U0001f3fe\u200d\u2640\ufe0f", "woman running: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f3c3\U0001f3ff\u200d\u2640\ufe0f", "woman running: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f3c3\u200d\u27a1\ufe0f", "person running facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f3c3\U0001f3fb\u200d\u27a1\ufe0f", "person running facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3\U0001f3fc\u200d\u27a1\ufe0f", "person running facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3\U0001f3fd\u200d\u27a1\ufe0f", "person running facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3\U0001f3fe\u200d\u27a1\ufe0f", "person running facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3\U0001f3ff\u200d\u27a1\ufe0f", "person running facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman running facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f3c3\U0001f3fb\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman running facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3\U0001f3fc\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman running facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3\U0001f3fd\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman running facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3\U0001f3fe\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman running facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3\U0001f3ff\u200d\u2640\ufe0f\u200d\u27a1\ufe0f", "woman running facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man running facing right", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", true},
+			{"\U0001f3c3\U0001f3fb\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man running facing right: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3\U0001f3fc\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man running facing right: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3\U0001f3fd\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man running facing right: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3\U0001f3fe\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man running facing right: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f3c3\U0001f3ff\u200d\u2642\ufe0f\u200d\u27a1\ufe0f", "man running facing right: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "15.1", false},
+			{"\U0001f483", "woman dancing", "People & Body", "person-activity", []string{"activity", "person"}, "0.6", true},
+			{"\U0001f483\U0001f3fb", "woman dancing: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f483\U0001f3fc", "woman dancing: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f483\U0001f3fd", "woman dancing: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f483\U0001f3fe", "woman dancing: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f483\U0001f3ff", "woman dancing: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "1.0", false},
+			{"\U0001f57a", "man dancing", "People & Body", "person-activity", []string{"activity", "person"}, "3.0", true},
+			{"\U0001f57a\U0001f3fb", "man dancing: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "3.0", false},
+			{"\U0001f57a\U0001f3fc", "man dancing: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "3.0", false},
+			{"\U0001f57a\U0001f3fd", "man dancing: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "3.0", false},
+			{"\U0001f57a\U0001f3fe", "man dancing: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "3.0", false},
+			{"\U0001f57a\U0001f3ff", "man dancing: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "3.0", false},
+			{"\U0001f574\ufe0f", "person in suit levitating", "People & Body", "person-activity", []string{"activity", "person"}, "0.7", true},
+			{"\U0001f574\U0001f3fb", "person in suit levitating: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f574\U0001f3fc", "person in suit levitating: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f574\U0001f3fd", "person in suit levitating: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f574\U0001f3fe", "person in suit levitating: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f574\U0001f3ff", "person in suit levitating: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f46f", "people with bunny ears", "People & Body", "person-activity", []string{"activity", "person"}, "0.6", false},
+			{"\U0001f46f\u200d\u2642\ufe0f", "men with bunny ears", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f46f\u200d\u2640\ufe0f", "women with bunny ears", "People & Body", "person-activity", []string{"activity", "person"}, "4.0", false},
+			{"\U0001f9d6", "person in steamy room", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", true},
+			{"\U0001f9d6\U0001f3fb", "person in steamy room: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d6\U0001f3fc", "person in steamy room: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d6\U0001f3fd", "person in steamy room: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d6\U0001f3fe", "person in steamy room: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d6\U0001f3ff", "person in steamy room: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d6\u200d\u2642\ufe0f", "man in steamy room", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", true},
+			{"\U0001f9d6\U0001f3fb\u200d\u2642\ufe0f", "man in steamy room: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d6\U0001f3fc\u200d\u2642\ufe0f", "man in steamy room: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d6\U0001f3fd\u200d\u2642\ufe0f", "man in steamy room: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d6\U0001f3fe\u200d\u2642\ufe0f", "man in steamy room: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d6\U0001f3ff\u200d\u2642\ufe0f", "man in steamy room: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d6\u200d\u2640\ufe0f", "woman in steamy room", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", true},
+			{"\U0001f9d6\U0001f3fb\u200d\u2640\ufe0f", "woman in steamy room: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d6\U0001f3fc\u200d\u2640\ufe0f", "woman in steamy room: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d6\U0001f3fd\u200d\u2640\ufe0f", "woman in steamy room: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d6\U0001f3fe\u200d\u2640\ufe0f", "woman in steamy room: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d6\U0001f3ff\u200d\u2640\ufe0f", "woman in steamy room: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7", "person climbing", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", true},
+			{"\U0001f9d7\U0001f3fb", "person climbing: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7\U0001f3fc", "person climbing: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7\U0001f3fd", "person climbing: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7\U0001f3fe", "person climbing: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7\U0001f3ff", "person climbing: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7\u200d\u2642\ufe0f", "man climbing", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", true},
+			{"\U0001f9d7\U0001f3fb\u200d\u2642\ufe0f", "man climbing: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7\U0001f3fc\u200d\u2642\ufe0f", "man climbing: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7\U0001f3fd\u200d\u2642\ufe0f", "man climbing: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7\U0001f3fe\u200d\u2642\ufe0f", "man climbing: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7\U0001f3ff\u200d\u2642\ufe0f", "man climbing: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7\u200d\u2640\ufe0f", "woman climbing", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", true},
+			{"\U0001f9d7\U0001f3fb\u200d\u2640\ufe0f", "woman climbing: light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7\U0001f3fc\u200d\u2640\ufe0f", "woman climbing: medium-light skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7\U0001f3fd\u200d\u2640\ufe0f", "woman climbing: medium skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7\U0001f3fe\u200d\u2640\ufe0f", "woman climbing: medium-dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f9d7\U0001f3ff\u200d\u2640\ufe0f", "woman climbing: dark skin tone", "People & Body", "person-activity", []string{"activity", "person"}, "5.0", false},
+			{"\U0001f93a", "person fencing", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f3c7", "horse racing", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", true},
+			{"\U0001f3c7\U0001f3fb", "horse racing: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3c7\U0001f3fc", "horse racing: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3c7\U0001f3fd", "horse racing: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3c7\U0001f3fe", "horse racing: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3c7\U0001f3ff", "horse racing: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\u26f7\ufe0f", "skier", "People & Body", "person-sport", []string{"person", "sport"}, "0.7", false},
+			{"\U0001f3c2", "snowboarder", "People & Body", "person-sport", []string{"person", "sport"}, "0.6", true},
+			{"\U0001f3c2\U0001f3fb", "snowboarder: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3c2\U0001f3fc", "snowboarder: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3c2\U0001f3fd", "snowboarder: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3c2\U0001f3fe", "snowboarder: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3c2\U0001f3ff", "snowboarder: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3cc\ufe0f", "person golfing", "People & Body", "person-sport", []string{"person", "sport"}, "0.7", true},
+			{"\U0001f3cc\U0001f3fb", "person golfing: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cc\U0001f3fc", "person golfing: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cc\U0001f3fd", "person golfing: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cc\U0001f3fe", "person golfing: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cc\U0001f3ff", "person golfing: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cc\ufe0f\u200d\u2642\ufe0f", "man golfing", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f3cc\U0001f3fb\u200d\u2642\ufe0f", "man golfing: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cc\U0001f3fc\u200d\u2642\ufe0f", "man golfing: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cc\U0001f3fd\u200d\u2642\ufe0f", "man golfing: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cc\U0001f3fe\u200d\u2642\ufe0f", "man golfing: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cc\U0001f3ff\u200d\u2642\ufe0f", "man golfing: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cc\ufe0f\u200d\u2640\ufe0f", "woman golfing", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f3cc\U0001f3fb\u200d\u2640\ufe0f", "woman golfing: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cc\U0001f3fc\u200d\u2640\ufe0f", "woman golfing: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cc\U0001f3fd\u200d\u2640\ufe0f", "woman golfing: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cc\U0001f3fe\u200d\u2640\ufe0f", "woman golfing: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cc\U0001f3ff\u200d\u2640\ufe0f", "woman golfing: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3c4", "person surfing", "People & Body", "person-sport", []string{"person", "sport"}, "0.6", true},
+			{"\U0001f3c4\U0001f3fb", "person surfing: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3c4\U0001f3fc", "person surfing: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3c4\U0001f3fd", "person surfing: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3c4\U0001f3fe", "person surfing: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3c4\U0001f3ff", "person surfing: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3c4\u200d\u2642\ufe0f", "man surfing", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f3c4\U0001f3fb\u200d\u2642\ufe0f", "man surfing: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3c4\U0001f3fc\u200d\u2642\ufe0f", "man surfing: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3c4\U0001f3fd\u200d\u2642\ufe0f", "man surfing: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3c4\U0001f3fe\u200d\u2642\ufe0f", "man surfing: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3c4\U0001f3ff\u200d\u2642\ufe0f", "man surfing: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3c4\u200d\u2640\ufe0f", "woman surfing", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f3c4\U0001f3fb\u200d\u2640\ufe0f", "woman surfing: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3c4\U0001f3fc\u200d\u2640\ufe0f", "woman surfing: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3c4\U0001f3fd\u200d\u2640\ufe0f", "woman surfing: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3c4\U0001f3fe\u200d\u2640\ufe0f", "woman surfing: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3c4\U0001f3ff\u200d\u2640\ufe0f", "woman surfing: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6a3", "person rowing boat", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", true},
+			{"\U0001f6a3\U0001f3fb", "person rowing boat: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6a3\U0001f3fc", "person rowing boat: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6a3\U0001f3fd", "person rowing boat: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6a3\U0001f3fe", "person rowing boat: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6a3\U0001f3ff", "person rowing boat: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6a3\u200d\u2642\ufe0f", "man rowing boat", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f6a3\U0001f3fb\u200d\u2642\ufe0f", "man rowing boat: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6a3\U0001f3fc\u200d\u2642\ufe0f", "man rowing boat: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6a3\U0001f3fd\u200d\u2642\ufe0f", "man rowing boat: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6a3\U0001f3fe\u200d\u2642\ufe0f", "man rowing boat: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6a3\U0001f3ff\u200d\u2642\ufe0f", "man rowing boat: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6a3\u200d\u2640\ufe0f", "woman rowing boat", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f6a3\U0001f3fb\u200d\u2640\ufe0f", "woman rowing boat: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6a3\U0001f3fc\u200d\u2640\ufe0f", "woman rowing boat: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6a3\U0001f3fd\u200d\u2640\ufe0f", "woman rowing boat: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6a3\U0001f3fe\u200d\u2640\ufe0f", "woman rowing boat: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6a3\U0001f3ff\u200d\u2640\ufe0f", "woman rowing boat: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3ca", "person swimming", "People & Body", "person-sport", []string{"person", "sport"}, "0.6", true},
+			{"\U0001f3ca\U0001f3fb", "person swimming: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3ca\U0001f3fc", "person swimming: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3ca\U0001f3fd", "person swimming: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3ca\U0001f3fe", "person swimming: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3ca\U0001f3ff", "person swimming: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f3ca\u200d\u2642\ufe0f", "man swimming", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f3ca\U0001f3fb\u200d\u2642\ufe0f", "man swimming: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3ca\U0001f3fc\u200d\u2642\ufe0f", "man swimming: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3ca\U0001f3fd\u200d\u2642\ufe0f", "man swimming: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3ca\U0001f3fe\u200d\u2642\ufe0f", "man swimming: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3ca\U0001f3ff\u200d\u2642\ufe0f", "man swimming: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3ca\u200d\u2640\ufe0f", "woman swimming", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f3ca\U0001f3fb\u200d\u2640\ufe0f", "woman swimming: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3ca\U0001f3fc\u200d\u2640\ufe0f", "woman swimming: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3ca\U0001f3fd\u200d\u2640\ufe0f", "woman swimming: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3ca\U0001f3fe\u200d\u2640\ufe0f", "woman swimming: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3ca\U0001f3ff\u200d\u2640\ufe0f", "woman swimming: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\u26f9\ufe0f", "person bouncing ball", "People & Body", "person-sport", []string{"person", "sport"}, "0.7", true},
+			{"\u26f9\U0001f3fb", "person bouncing ball: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "2.0", false},
+			{"\u26f9\U0001f3fc", "person bouncing ball: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "2.0", false},
+			{"\u26f9\U0001f3fd", "person bouncing ball: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "2.0", false},
+			{"\u26f9\U0001f3fe", "person bouncing ball: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "2.0", false},
+			{"\u26f9\U0001f3ff", "person bouncing ball: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "2.0", false},
+			{"\u26f9\ufe0f\u200d\u2642\ufe0f", "man bouncing ball", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\u26f9\U0001f3fb\u200d\u2642\ufe0f", "man bouncing ball: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\u26f9\U0001f3fc\u200d\u2642\ufe0f", "man bouncing ball: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\u26f9\U0001f3fd\u200d\u2642\ufe0f", "man bouncing ball: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\u26f9\U0001f3fe\u200d\u2642\ufe0f", "man bouncing ball: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\u26f9\U0001f3ff\u200d\u2642\ufe0f", "man bouncing ball: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\u26f9\ufe0f\u200d\u2640\ufe0f", "woman bouncing ball", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\u26f9\U0001f3fb\u200d\u2640\ufe0f", "woman bouncing ball: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\u26f9\U0001f3fc\u200d\u2640\ufe0f", "woman bouncing ball: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\u26f9\U0001f3fd\u200d\u2640\ufe0f", "woman bouncing ball: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\u26f9\U0001f3fe\u200d\u2640\ufe0f", "woman bouncing ball: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\u26f9\U0001f3ff\u200d\u2640\ufe0f", "woman bouncing ball: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cb\ufe0f", "person lifting weights", "People & Body", "person-sport", []string{"person", "sport"}, "0.7", true},
+			{"\U0001f3cb\U0001f3fb", "person lifting weights: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "2.0", false},
+			{"\U0001f3cb\U0001f3fc", "person lifting weights: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "2.0", false},
+			{"\U0001f3cb\U0001f3fd", "person lifting weights: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "2.0", false},
+			{"\U0001f3cb\U0001f3fe", "person lifting weights: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "2.0", false},
+			{"\U0001f3cb\U0001f3ff", "person lifting weights: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "2.0", false},
+			{"\U0001f3cb\ufe0f\u200d\u2642\ufe0f", "man lifting weights", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f3cb\U0001f3fb\u200d\u2642\ufe0f", "man lifting weights: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cb\U0001f3fc\u200d\u2642\ufe0f", "man lifting weights: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cb\U0001f3fd\u200d\u2642\ufe0f", "man lifting weights: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cb\U0001f3fe\u200d\u2642\ufe0f", "man lifting weights: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cb\U0001f3ff\u200d\u2642\ufe0f", "man lifting weights: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cb\ufe0f\u200d\u2640\ufe0f", "woman lifting weights", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f3cb\U0001f3fb\u200d\u2640\ufe0f", "woman lifting weights: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cb\U0001f3fc\u200d\u2640\ufe0f", "woman lifting weights: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cb\U0001f3fd\u200d\u2640\ufe0f", "woman lifting weights: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cb\U0001f3fe\u200d\u2640\ufe0f", "woman lifting weights: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f3cb\U0001f3ff\u200d\u2640\ufe0f", "woman lifting weights: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b4", "person biking", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", true},
+			{"\U0001f6b4\U0001f3fb", "person biking: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6b4\U0001f3fc", "person biking: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6b4\U0001f3fd", "person biking: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6b4\U0001f3fe", "person biking: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6b4\U0001f3ff", "person biking: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6b4\u200d\u2642\ufe0f", "man biking", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f6b4\U0001f3fb\u200d\u2642\ufe0f", "man biking: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b4\U0001f3fc\u200d\u2642\ufe0f", "man biking: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b4\U0001f3fd\u200d\u2642\ufe0f", "man biking: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b4\U0001f3fe\u200d\u2642\ufe0f", "man biking: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b4\U0001f3ff\u200d\u2642\ufe0f", "man biking: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b4\u200d\u2640\ufe0f", "woman biking", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f6b4\U0001f3fb\u200d\u2640\ufe0f", "woman biking: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b4\U0001f3fc\u200d\u2640\ufe0f", "woman biking: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b4\U0001f3fd\u200d\u2640\ufe0f", "woman biking: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b4\U0001f3fe\u200d\u2640\ufe0f", "woman biking: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b4\U0001f3ff\u200d\u2640\ufe0f", "woman biking: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b5", "person mountain biking", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", true},
+			{"\U0001f6b5\U0001f3fb", "person mountain biking: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6b5\U0001f3fc", "person mountain biking: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6b5\U0001f3fd", "person mountain biking: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6b5\U0001f3fe", "person mountain biking: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6b5\U0001f3ff", "person mountain biking: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "1.0", false},
+			{"\U0001f6b5\u200d\u2642\ufe0f", "man mountain biking", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f6b5\U0001f3fb\u200d\u2642\ufe0f", "man mountain biking: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b5\U0001f3fc\u200d\u2642\ufe0f", "man mountain biking: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b5\U0001f3fd\u200d\u2642\ufe0f", "man mountain biking: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b5\U0001f3fe\u200d\u2642\ufe0f", "man mountain biking: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b5\U0001f3ff\u200d\u2642\ufe0f", "man mountain biking: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b5\u200d\u2640\ufe0f", "woman mountain biking", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f6b5\U0001f3fb\u200d\u2640\ufe0f", "woman mountain biking: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b5\U0001f3fc\u200d\u2640\ufe0f", "woman mountain biking: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b5\U0001f3fd\u200d\u2640\ufe0f", "woman mountain biking: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b5\U0001f3fe\u200d\u2640\ufe0f", "woman mountain biking: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f6b5\U0001f3ff\u200d\u2640\ufe0f", "woman mountain biking: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f938", "person cartwheeling", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", true},
+			{"\U0001f938\U0001f3fb", "person cartwheeling: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f938\U0001f3fc", "person cartwheeling: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f938\U0001f3fd", "person cartwheeling: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f938\U0001f3fe", "person cartwheeling: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f938\U0001f3ff", "person cartwheeling: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f938\u200d\u2642\ufe0f", "man cartwheeling", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f938\U0001f3fb\u200d\u2642\ufe0f", "man cartwheeling: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f938\U0001f3fc\u200d\u2642\ufe0f", "man cartwheeling: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f938\U0001f3fd\u200d\u2642\ufe0f", "man cartwheeling: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f938\U0001f3fe\u200d\u2642\ufe0f", "man cartwheeling: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f938\U0001f3ff\u200d\u2642\ufe0f", "man cartwheeling: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f938\u200d\u2640\ufe0f", "woman cartwheeling", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f938\U0001f3fb\u200d\u2640\ufe0f", "woman cartwheeling: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f938\U0001f3fc\u200d\u2640\ufe0f", "woman cartwheeling: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f938\U0001f3fd\u200d\u2640\ufe0f", "woman cartwheeling: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f938\U0001f3fe\u200d\u2640\ufe0f", "woman cartwheeling: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f938\U0001f3ff\u200d\u2640\ufe0f", "woman cartwheeling: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93c", "people wrestling", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f93c\u200d\u2642\ufe0f", "men wrestling", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93c\u200d\u2640\ufe0f", "women wrestling", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93d", "person playing water polo", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", true},
+			{"\U0001f93d\U0001f3fb", "person playing water polo: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f93d\U0001f3fc", "person playing water polo: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f93d\U0001f3fd", "person playing water polo: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f93d\U0001f3fe", "person playing water polo: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f93d\U0001f3ff", "person playing water polo: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f93d\u200d\u2642\ufe0f", "man playing water polo", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f93d\U0001f3fb\u200d\u2642\ufe0f", "man playing water polo: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93d\U0001f3fc\u200d\u2642\ufe0f", "man playing water polo: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93d\U0001f3fd\u200d\u2642\ufe0f", "man playing water polo: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93d\U0001f3fe\u200d\u2642\ufe0f", "man playing water polo: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93d\U0001f3ff\u200d\u2642\ufe0f", "man playing water polo: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93d\u200d\u2640\ufe0f", "woman playing water polo", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f93d\U0001f3fb\u200d\u2640\ufe0f", "woman playing water polo: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93d\U0001f3fc\u200d\u2640\ufe0f", "woman playing water polo: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93d\U0001f3fd\u200d\u2640\ufe0f", "woman playing water polo: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93d\U0001f3fe\u200d\u2640\ufe0f", "woman playing water polo: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93d\U0001f3ff\u200d\u2640\ufe0f", "woman playing water polo: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93e", "person playing handball", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", true},
+			{"\U0001f93e\U0001f3fb", "person playing handball: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f93e\U0001f3fc", "person playing handball: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f93e\U0001f3fd", "person playing handball: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f93e\U0001f3fe", "person playing handball: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f93e\U0001f3ff", "person playing handball: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f93e\u200d\u2642\ufe0f", "man playing handball", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f93e\U0001f3fb\u200d\u2642\ufe0f", "man playing handball: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93e\U0001f3fc\u200d\u2642\ufe0f", "man playing handball: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93e\U0001f3fd\u200d\u2642\ufe0f", "man playing handball: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93e\U0001f3fe\u200d\u2642\ufe0f", "man playing handball: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93e\U0001f3ff\u200d\u2642\ufe0f", "man playing handball: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93e\u200d\u2640\ufe0f", "woman playing handball", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f93e\U0001f3fb\u200d\u2640\ufe0f", "woman playing handball: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93e\U0001f3fc\u200d\u2640\ufe0f", "woman playing handball: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93e\U0001f3fd\u200d\u2640\ufe0f", "woman playing handball: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93e\U0001f3fe\u200d\u2640\ufe0f", "woman playing handball: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f93e\U0001f3ff\u200d\u2640\ufe0f", "woman playing handball: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f939", "person juggling", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", true},
+			{"\U0001f939\U0001f3fb", "person juggling: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f939\U0001f3fc", "person juggling: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f939\U0001f3fd", "person juggling: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f939\U0001f3fe", "person juggling: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f939\U0001f3ff", "person juggling: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "3.0", false},
+			{"\U0001f939\u200d\u2642\ufe0f", "man juggling", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f939\U0001f3fb\u200d\u2642\ufe0f", "man juggling: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f939\U0001f3fc\u200d\u2642\ufe0f", "man juggling: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f939\U0001f3fd\u200d\u2642\ufe0f", "man juggling: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f939\U0001f3fe\u200d\u2642\ufe0f", "man juggling: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f939\U0001f3ff\u200d\u2642\ufe0f", "man juggling: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f939\u200d\u2640\ufe0f", "woman juggling", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", true},
+			{"\U0001f939\U0001f3fb\u200d\u2640\ufe0f", "woman juggling: light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f939\U0001f3fc\u200d\u2640\ufe0f", "woman juggling: medium-light skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f939\U0001f3fd\u200d\u2640\ufe0f", "woman juggling: medium skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f939\U0001f3fe\u200d\u2640\ufe0f", "woman juggling: medium-dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f939\U0001f3ff\u200d\u2640\ufe0f", "woman juggling: dark skin tone", "People & Body", "person-sport", []string{"person", "sport"}, "4.0", false},
+			{"\U0001f9d8", "person in lotus position", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", true},
+			{"\U0001f9d8\U0001f3fb", "person in lotus position: light skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f9d8\U0001f3fc", "person in lotus position: medium-light skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f9d8\U0001f3fd", "person in lotus position: medium skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f9d8\U0001f3fe", "person in lotus position: medium-dark skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f9d8\U0001f3ff", "person in lotus position: dark skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f9d8\u200d\u2642\ufe0f", "man in lotus position", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", true},
+			{"\U0001f9d8\U0001f3fb\u200d\u2642\ufe0f", "man in lotus position: light skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f9d8\U0001f3fc\u200d\u2642\ufe0f", "man in lotus position: medium-light skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f9d8\U0001f3fd\u200d\u2642\ufe0f", "man in lotus position: medium skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f9d8\U0001f3fe\u200d\u2642\ufe0f", "man in lotus position: medium-dark skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f9d8\U0001f3ff\u200d\u2642\ufe0f", "man in lotus position: dark skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f9d8\u200d\u2640\ufe0f", "woman in lotus position", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", true},
+			{"\U0001f9d8\U0001f3fb\u200d\u2640\ufe0f", "woman in lotus position: light skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f9d8\U0001f3fc\u200d\u2640\ufe0f", "woman in lotus position: medium-light skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f9d8\U0001f3fd\u200d\u2640\ufe0f", "woman in lotus position: medium skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f9d8\U0001f3fe\u200d\u2640\ufe0f", "woman in lotus position: medium-dark skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f9d8\U0001f3ff\u200d\u2640\ufe0f", "woman in lotus position: dark skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "5.0", false},
+			{"\U0001f6c0", "person taking bath", "People & Body", "person-resting", []string{"person", "resting"}, "0.6", true},
+			{"\U0001f6c0\U0001f3fb", "person taking bath: light skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "1.0", false},
+			{"\U0001f6c0\U0001f3fc", "person taking bath: medium-light skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "1.0", false},
+			{"\U0001f6c0\U0001f3fd", "person taking bath: medium skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "1.0", false},
+			{"\U0001f6c0\U0001f3fe", "person taking bath: medium-dark skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "1.0", false},
+			{"\U0001f6c0\U0001f3ff", "person taking bath: dark skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "1.0", false},
+			{"\U0001f6cc", "person in bed", "People & Body", "person-resting", []string{"person", "resting"}, "1.0", true},
+			{"\U0001f6cc\U0001f3fb", "person in bed: light skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "4.0", false},
+			{"\U0001f6cc\U0001f3fc", "person in bed: medium-light skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "4.0", false},
+			{"\U0001f6cc\U0001f3fd", "person in bed: medium skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "4.0", false},
+			{"\U0001f6cc\U0001f3fe", "person in bed: medium-dark skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "4.0", false},
+			{"\U0001f6cc\U0001f3ff", "person in bed: dark skin tone", "People & Body", "person-resting", []string{"person", "resting"}, "4.0", false},
+			{"\U0001f9d1\u200d\U0001f91d\u200d\U0001f9d1", "people holding hands", "People & Body", "family", []string{"family"}, "12.0", true},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fb", "people holding hands: light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fc", "people holding hands: light skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fd", "people holding hands: light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fe", "people holding hands: light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\U0001f91d\u200d\U0001f9d1\U0001f3ff", "people holding hands: light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fb", "people holding hands: medium-light skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fc", "people holding hands: medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fd", "people holding hands: medium-light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fe", "people holding hands: medium-light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\U0001f91d\u200d\U0001f9d1\U0001f3ff", "people holding hands: medium-light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fb", "people holding hands: medium skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fc", "people holding hands: medium skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fd", "people holding hands: medium skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fe", "people holding hands: medium skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\U0001f91d\u200d\U0001f9d1\U0001f3ff", "people holding hands: medium skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fb", "people holding hands: medium-dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fc", "people holding hands: medium-dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fd", "people holding hands: medium-dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fe", "people holding hands: medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f9d1\U0001f3fe\u200d\U0001f91d\u200d\U0001f9d1\U0001f3ff", "people holding hands: medium-dark skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fb", "people holding hands: dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fc", "people holding hands: dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fd", "people holding hands: dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fe", "people holding hands: dark skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f9d1\U0001f3ff\u200d\U0001f91d\u200d\U0001f9d1\U0001f3ff", "people holding hands: dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46d", "women holding hands", "People & Body", "family", []string{"family"}, "1.0", true},
+			{"\U0001f46d\U0001f3fb", "women holding hands: light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f469\U0001f3fc", "women holding hands: light skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f469\U0001f3fd", "women holding hands: light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f469\U0001f3fe", "women holding hands: light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f469\U0001f3ff", "women holding hands: light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f469\U0001f3fb", "women holding hands: medium-light skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46d\U0001f3fc", "women holding hands: medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f469\U0001f3fd", "women holding hands: medium-light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f469\U0001f3fe", "women holding hands: medium-light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f469\U0001f3ff", "women holding hands: medium-light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f469\U0001f3fb", "women holding hands: medium skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f469\U0001f3fc", "women holding hands: medium skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46d\U0001f3fd", "women holding hands: medium skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f469\U0001f3fe", "women holding hands: medium skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f469\U0001f3ff", "women holding hands: medium skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f469\U0001f3fb", "women holding hands: medium-dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f469\U0001f3fc", "women holding hands: medium-dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f469\U0001f3fd", "women holding hands: medium-dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46d\U0001f3fe", "women holding hands: medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f469\U0001f3ff", "women holding hands: medium-dark skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f469\U0001f3fb", "women holding hands: dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f469\U0001f3fc", "women holding hands: dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f469\U0001f3fd", "women holding hands: dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f469\U0001f3fe", "women holding hands: dark skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46d\U0001f3ff", "women holding hands: dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46b", "woman and man holding hands", "People & Body", "family", []string{"family"}, "0.6", true},
+			{"\U0001f46b\U0001f3fb", "woman and man holding hands: light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3fc", "woman and man holding hands: light skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3fd", "woman and man holding hands: light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3fe", "woman and man holding hands: light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3ff", "woman and man holding hands: light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3fb", "woman and man holding hands: medium-light skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46b\U0001f3fc", "woman and man holding hands: medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3fd", "woman and man holding hands: medium-light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3fe", "woman and man holding hands: medium-light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3ff", "woman and man holding hands: medium-light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3fb", "woman and man holding hands: medium skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3fc", "woman and man holding hands: medium skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46b\U0001f3fd", "woman and man holding hands: medium skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3fe", "woman and man holding hands: medium skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3ff", "woman and man holding hands: medium skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3fb", "woman and man holding hands: medium-dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3fc", "woman and man holding hands: medium-dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3fd", "woman and man holding hands: medium-dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46b\U0001f3fe", "woman and man holding hands: medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3ff", "woman and man holding hands: medium-dark skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fb", "woman and man holding hands: dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fc", "woman and man holding hands: dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fd", "woman and man holding hands: dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fe", "woman and man holding hands: dark skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46b\U0001f3ff", "woman and man holding hands: dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46c", "men holding hands", "People & Body", "family", []string{"family"}, "1.0", true},
+			{"\U0001f46c\U0001f3fb", "men holding hands: light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f468\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3fc", "men holding hands: light skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f468\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3fd", "men holding hands: light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f468\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3fe", "men holding hands: light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f468\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3ff", "men holding hands: light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3fb", "men holding hands: medium-light skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46c\U0001f3fc", "men holding hands: medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3fd", "men holding hands: medium-light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3fe", "men holding hands: medium-light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f468\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3ff", "men holding hands: medium-light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3fb", "men holding hands: medium skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3fc", "men holding hands: medium skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46c\U0001f3fd", "men holding hands: medium skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3fe", "men holding hands: medium skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f468\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3ff", "men holding hands: medium skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3fb", "men holding hands: medium-dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3fc", "men holding hands: medium-dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3fd", "men holding hands: medium-dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46c\U0001f3fe", "men holding hands: medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f468\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3ff", "men holding hands: medium-dark skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "12.1", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fb", "men holding hands: dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fc", "men holding hands: dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fd", "men holding hands: dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f468\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fe", "men holding hands: dark skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f46c\U0001f3ff", "men holding hands: dark skin tone", "People & Body", "family", []string{"family"}, "12.0", false},
+			{"\U0001f48f", "kiss", "People & Body", "family", []string{"family"}, "0.6", true},
+			{"\U0001f48f\U0001f3fb", "kiss: light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f48f\U0001f3fc", "kiss: medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f48f\U0001f3fd", "kiss: medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f48f\U0001f3fe", "kiss: medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f48f\U0001f3ff", "kiss: dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fc", "kiss: person, person, light skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fd", "kiss: person, person, light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fe", "kiss: person, person, light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3ff", "kiss: person, person, light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fb", "kiss: person, person, medium-light skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fd", "kiss: person, person, medium-light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fe", "kiss: person, person, medium-light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3ff", "kiss: person, person, medium-light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fb", "kiss: person, person, medium skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fc", "kiss: person, person, medium skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fe", "kiss: person, person, medium skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3ff", "kiss: person, person, medium skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fb", "kiss: person, person, medium-dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fc", "kiss: person, person, medium-dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fd", "kiss: person, person, medium-dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3ff", "kiss: person, person, medium-dark skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fb", "kiss: person, person, dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fc", "kiss: person, person, dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fd", "kiss: person, person, dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fe", "kiss: person, person, dark skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468", "kiss: woman, man", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fb", "kiss: woman, man, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fc", "kiss: woman, man, light skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fd", "kiss: woman, man, light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fe", "kiss: woman, man, light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3ff", "kiss: woman, man, light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fb", "kiss: woman, man, medium-light skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fc", "kiss: woman, man, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fd", "kiss: woman, man, medium-light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fe", "kiss: woman, man, medium-light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3ff", "kiss: woman, man, medium-light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fb", "kiss: woman, man, medium skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fc", "kiss: woman, man, medium skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fd", "kiss: woman, man, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fe", "kiss: woman, man, medium skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3ff", "kiss: woman, man, medium skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fb", "kiss: woman, man, medium-dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fc", "kiss: woman, man, medium-dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fd", "kiss: woman, man, medium-dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fe", "kiss: woman, man, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3ff", "kiss: woman, man, medium-dark skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fb", "kiss: woman, man, dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fc", "kiss: woman, man, dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fd", "kiss: woman, man, dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fe", "kiss: woman, man, dark skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3ff", "kiss: woman, man, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468", "kiss: man, man", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f468\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fb", "kiss: man, man, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fc", "kiss: man, man, light skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fd", "kiss: man, man, light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fe", "kiss: man, man, light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3ff", "kiss: man, man, light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fb", "kiss: man, man, medium-light skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fc", "kiss: man, man, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fd", "kiss: man, man, medium-light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fe", "kiss: man, man, medium-light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3ff", "kiss: man, man, medium-light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fb", "kiss: man, man, medium skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fc", "kiss: man, man, medium skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fd", "kiss: man, man, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fe", "kiss: man, man, medium skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3ff", "kiss: man, man, medium skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fb", "kiss: man, man, medium-dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fc", "kiss: man, man, medium-dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fd", "kiss: man, man, medium-dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fe", "kiss: man, man, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3ff", "kiss: man, man, medium-dark skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fb", "kiss: man, man, dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fc", "kiss: man, man, dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fd", "kiss: man, man, dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3fe", "kiss: man, man, dark skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f468\U0001f3ff", "kiss: man, man, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469", "kiss: woman, woman", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fb", "kiss: woman, woman, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fc", "kiss: woman, woman, light skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fd", "kiss: woman, woman, light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fe", "kiss: woman, woman, light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3ff", "kiss: woman, woman, light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fb", "kiss: woman, woman, medium-light skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fc", "kiss: woman, woman, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fd", "kiss: woman, woman, medium-light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fe", "kiss: woman, woman, medium-light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3ff", "kiss: woman, woman, medium-light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fb", "kiss: woman, woman, medium skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fc", "kiss: woman, woman, medium skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fd", "kiss: woman, woman, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fe", "kiss: woman, woman, medium skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3ff", "kiss: woman, woman, medium skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fb", "kiss: woman, woman, medium-dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fc", "kiss: woman, woman, medium-dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fd", "kiss: woman, woman, medium-dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fe", "kiss: woman, woman, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3ff", "kiss: woman, woman, medium-dark skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fb", "kiss: woman, woman, dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fc", "kiss: woman, woman, dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fd", "kiss: woman, woman, dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3fe", "kiss: woman, woman, dark skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f48b\u200d\U0001f469\U0001f3ff", "kiss: woman, woman, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f491", "couple with heart", "People & Body", "family", []string{"family"}, "0.6", true},
+			{"\U0001f491\U0001f3fb", "couple with heart: light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f491\U0001f3fc", "couple with heart: medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f491\U0001f3fd", "couple with heart: medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f491\U0001f3fe", "couple with heart: medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f491\U0001f3ff", "couple with heart: dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fc", "couple with heart: person, person, light skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fd", "couple with heart: person, person, light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fe", "couple with heart: person, person, light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3ff", "couple with heart: person, person, light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fb", "couple with heart: person, person, medium-light skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fd", "couple with heart: person, person, medium-light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fe", "couple with heart: person, person, medium-light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3ff", "couple with heart: person, person, medium-light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fb", "couple with heart: person, person, medium skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fc", "couple with heart: person, person, medium skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fe", "couple with heart: person, person, medium skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3ff", "couple with heart: person, person, medium skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fb", "couple with heart: person, person, medium-dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fc", "couple with heart: person, person, medium-dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fd", "couple with heart: person, person, medium-dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3ff", "couple with heart: person, person, medium-dark skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fb", "couple with heart: person, person, dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fc", "couple with heart: person, person, dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fd", "couple with heart: person, person, dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f9d1\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f9d1\U0001f3fe", "couple with heart: person, person, dark skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f468", "couple with heart: woman, man", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fb", "couple with heart: woman, man, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fc", "couple with heart: woman, man, light skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fd", "couple with heart: woman, man, light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fe", "couple with heart: woman, man, light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3ff", "couple with heart: woman, man, light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fb", "couple with heart: woman, man, medium-light skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fc", "couple with heart: woman, man, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fd", "couple with heart: woman, man, medium-light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fe", "couple with heart: woman, man, medium-light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3ff", "couple with heart: woman, man, medium-light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fb", "couple with heart: woman, man, medium skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fc", "couple with heart: woman, man, medium skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fd", "couple with heart: woman, man, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fe", "couple with heart: woman, man, medium skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3ff", "couple with heart: woman, man, medium skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fb", "couple with heart: woman, man, medium-dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fc", "couple with heart: woman, man, medium-dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fd", "couple with heart: woman, man, medium-dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fe", "couple with heart: woman, man, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3ff", "couple with heart: woman, man, medium-dark skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fb", "couple with heart: woman, man, dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fc", "couple with heart: woman, man, dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fd", "couple with heart: woman, man, dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fe", "couple with heart: woman, man, dark skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3ff", "couple with heart: woman, man, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\u200d\u2764\ufe0f\u200d\U0001f468", "couple with heart: man, man", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f468\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fb", "couple with heart: man, man, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fc", "couple with heart: man, man, light skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fd", "couple with heart: man, man, light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fe", "couple with heart: man, man, light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3ff", "couple with heart: man, man, light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fb", "couple with heart: man, man, medium-light skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fc", "couple with heart: man, man, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fd", "couple with heart: man, man, medium-light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fe", "couple with heart: man, man, medium-light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3ff", "couple with heart: man, man, medium-light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fb", "couple with heart: man, man, medium skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fc", "couple with heart: man, man, medium skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fd", "couple with heart: man, man, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fe", "couple with heart: man, man, medium skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3ff", "couple with heart: man, man, medium skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fb", "couple with heart: man, man, medium-dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fc", "couple with heart: man, man, medium-dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fd", "couple with heart: man, man, medium-dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fe", "couple with heart: man, man, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3ff", "couple with heart: man, man, medium-dark skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fb", "couple with heart: man, man, dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fc", "couple with heart: man, man, dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fd", "couple with heart: man, man, dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3fe", "couple with heart: man, man, dark skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f468\U0001f3ff", "couple with heart: man, man, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\u200d\u2764\ufe0f\u200d\U0001f469", "couple with heart: woman, woman", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fb", "couple with heart: woman, woman, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fc", "couple with heart: woman, woman, light skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fd", "couple with heart: woman, woman, light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fe", "couple with heart: woman, woman, light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fb\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3ff", "couple with heart: woman, woman, light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fb", "couple with heart: woman, woman, medium-light skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fc", "couple with heart: woman, woman, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fd", "couple with heart: woman, woman, medium-light skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fe", "couple with heart: woman, woman, medium-light skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fc\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3ff", "couple with heart: woman, woman, medium-light skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fb", "couple with heart: woman, woman, medium skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fc", "couple with heart: woman, woman, medium skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fd", "couple with heart: woman, woman, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fe", "couple with heart: woman, woman, medium skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fd\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3ff", "couple with heart: woman, woman, medium skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fb", "couple with heart: woman, woman, medium-dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fc", "couple with heart: woman, woman, medium-dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fd", "couple with heart: woman, woman, medium-dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fe", "couple with heart: woman, woman, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3fe\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3ff", "couple with heart: woman, woman, medium-dark skin tone, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fb", "couple with heart: woman, woman, dark skin tone, light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fc", "couple with heart: woman, woman, dark skin tone, medium-light skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fd", "couple with heart: woman, woman, dark skin tone, medium skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3fe", "couple with heart: woman, woman, dark skin tone, medium-dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f469\U0001f3ff\u200d\u2764\ufe0f\u200d\U0001f469\U0001f3ff", "couple with heart: woman, woman, dark skin tone", "People & Body", "family", []string{"family"}, "13.1", false},
+			{"\U0001f468\u200d\U0001f469\u200d\U0001f466", "family: man, woman, boy", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f468\u200d\U0001f469\u200d\U0001f467", "family: man, woman, girl", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466", "family: man, woman, girl, boy", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f468\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466", "family: man, woman, boy, boy", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467", "family: man, woman, girl, girl", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f468\u200d\U0001f468\u200d\U0001f466", "family: man, man, boy", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f468\u200d\U0001f468\u200d\U0001f467", "family: man, man, girl", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f466", "family: man, man, girl, boy", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f468\u200d\U0001f468\u200d\U0001f466\u200d\U0001f466", "family: man, man, boy, boy", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f467", "family: man, man, girl, girl", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f469\u200d\U0001f469\u200d\U0001f466", "family: woman, woman, boy", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f469\u200d\U0001f469\u200d\U0001f467", "family: woman, woman, girl", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466", "family: woman, woman, girl, boy", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f469\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466", "family: woman, woman, boy, boy", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467", "family: woman, woman, girl, girl", "People & Body", "family", []string{"family"}, "2.0", false},
+			{"\U0001f468\u200d\U0001f466", "family: man, boy", "People & Body", "family", []string{"family"}, "4.0", false},
+			{"\U0001f468\u200d\U0001f466\u200d\U0001f466", "family: man, boy, boy", "People & Body", "family", []string{"family"}, "4.0", false},
+			{"\U0001f468\u200d\U0001f467", "family: man, girl", "People & Body", "family", []string{"family"}, "4.0", false},
+			{"\U0001f468\u200d\U0001f467\u200d\U0001f466", "family: man, girl, boy", "People & Body", "family", []string{"family"}, "4.0", false},
+			{"\U0001f468\u200d\U0001f467\u200d\U0001f467", "family: man, girl, girl", "People & Body", "family", []string{"family"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f466", "family: woman, boy", "People & Body", "family", []string{"family"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f466\u200d\U0001f466", "family: woman, boy, boy", "People & Body", "family", []string{"family"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f467", "family: woman, girl", "People & Body", "family", []string{"family"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f467\u200d\U0001f466", "family: woman, girl, boy", "People & Body", "family", []string{"family"}, "4.0", false},
+			{"\U0001f469\u200d\U0001f467\u200d\U0001f467", "family: woman, girl, girl", "People & Body", "family", []string{"family"}, "4.0", false},
+			{"\U0001f5e3\ufe0f", "speaking head", "People & Body", "person-symbol", []string{"person", "symbol"}, "0.7", false},
+			{"\U0001f464", "bust in silhouette", "People & Body", "person-symbol", []string{"person", "symbol"}, "0.6", false},
+			{"\U0001f465", "busts in silhouette", "People & Body", "person-symbol", []string{"person", "symbol"}, "1.0", false},
+			{"\U0001fac2", "people hugging", "People & Body", "person-symbol", []string{"person", "symbol"}, "13.0", false},
+			{"\U0001f46a", "family", "People & Body", "person-symbol", []string{"person", "symbol"}, "0.6", false},
+			{"\U0001f9d1\u200d\U0001f9d1\u200d\U0001f9d2", "family: adult, adult, child", "People & Body", "person-symbol", []string{"person", "symbol"}, "15.1", false},
+			{"\U0001f9d1\u200d\U0001f9d1\u200d\U0001f9d2\u200d\U0001f9d2", "family: adult, adult, child, child", "People & Body", "person-symbol", []string{"person", "symbol"}, "15.1", false},
+			{"\U0001f9d1\u200d\U0001f9d2", "family: adult, child", "People & Body", "person-symbol", []string{"person", "symbol"}, "15.1", false},
+			{"\U0001f9d1\u200d\U0001f9d2\u200d\U0001f9d2", "family: adult, child, child", "People & Body", "person-symbol", []string{"person", "symbol"}, "15.1", false},
+			{"\U0001f463", "footprints", "People & Body", "person-symbol", []string{"person", "symbol"}, "0.6", false},
+			{"\U0001f3fb", "light skin tone", "Component", "skin-tone", []string{"skin", "tone"}, "1.0", false},
+			{"\U0001f3fc", "medium-light skin tone", "Component", "skin-tone", []string{"skin", "tone"}, "1.0", false},
+			{"\U0001f3fd", "medium skin tone", "Component", "skin-tone", []string{"skin", "tone"}, "1.0", false},
+			{"\U0001f3fe", "medium-dark skin tone", "Component", "skin-tone", []string{"skin", "tone"}, "1.0", false},
+			{"\U0001f3ff", "dark skin tone", "Component", "skin-tone", []string{"skin", "tone"}, "1.0", false},
+			{"\U0001f9b0", "red hair", "Component", "hair-style", []string{"hair", "style"}, "11.0", false},
+			{"\U0001f9b1", "curly hair", "Component", "hair-style", []string{"hair", "style"}, "11.0", false},
+			{"\U0001f9b3", "white hair", "Component", "hair-style", []string{"hair", "style"}, "11.0", false},
+			{"\U0001f9b2", "bald", "Component", "hair-style", []string{"hair", "style"}, "11.0", false},
+			{"\U0001f435", "monkey face", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f412", "monkey", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f98d", "gorilla", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "3.0", false},
+			{"\U0001f9a7", "orangutan", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "12.0", false},
+			{"\U0001f436", "dog face", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f415", "dog", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.7", false},
+			{"\U0001f9ae", "guide dog", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "12.0", false},
+			{"\U0001f415\u200d\U0001f9ba", "service dog", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "12.0", false},
+			{"\U0001f429", "poodle", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f43a", "wolf", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f98a", "fox", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "3.0", false},
+			{"\U0001f99d", "raccoon", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "11.0", false},
+			{"\U0001f431", "cat face", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f408", "cat", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.7", false},
+			{"\U0001f408\u200d\u2b1b", "black cat", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "13.0", false},
+			{"\U0001f981", "lion", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "1.0", false},
+			{"\U0001f42f", "tiger face", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f405", "tiger", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "1.0", false},
+			{"\U0001f406", "leopard", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "1.0", false},
+			{"\U0001f434", "horse face", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001face", "moose", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "15.0", false},
+			{"\U0001facf", "donkey", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "15.0", false},
+			{"\U0001f40e", "horse", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f984", "unicorn", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "1.0", false},
+			{"\U0001f993", "zebra", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "5.0", false},
+			{"\U0001f98c", "deer", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "3.0", false},
+			{"\U0001f9ac", "bison", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "13.0", false},
+			{"\U0001f42e", "cow face", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f402", "ox", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "1.0", false},
+			{"\U0001f403", "water buffalo", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "1.0", false},
+			{"\U0001f404", "cow", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "1.0", false},
+			{"\U0001f437", "pig face", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f416", "pig", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "1.0", false},
+			{"\U0001f417", "boar", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f43d", "pig nose", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f40f", "ram", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "1.0", false},
+			{"\U0001f411", "ewe", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f410", "goat", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "1.0", false},
+			{"\U0001f42a", "camel", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "1.0", false},
+			{"\U0001f42b", "two-hump camel", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f999", "llama", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "11.0", false},
+			{"\U0001f992", "giraffe", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "5.0", false},
+			{"\U0001f418", "elephant", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f9a3", "mammoth", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "13.0", false},
+			{"\U0001f98f", "rhinoceros", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "3.0", false},
+			{"\U0001f99b", "hippopotamus", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "11.0", false},
+			{"\U0001f42d", "mouse face", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f401", "mouse", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "1.0", false},
+			{"\U0001f400", "rat", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "1.0", false},
+			{"\U0001f439", "hamster", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f430", "rabbit face", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f407", "rabbit", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "1.0", false},
+			{"\U0001f43f\ufe0f", "chipmunk", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.7", false},
+			{"\U0001f9ab", "beaver", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "13.0", false},
+			{"\U0001f994", "hedgehog", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "5.0", false},
+			{"\U0001f987", "bat", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "3.0", false},
+			{"\U0001f43b", "bear", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f43b\u200d\u2744\ufe0f", "polar bear", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "13.0", false},
+			{"\U0001f428", "koala", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f43c", "panda", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f9a5", "sloth", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "12.0", false},
+			{"\U0001f9a6", "otter", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "12.0", false},
+			{"\U0001f9a8", "skunk", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "12.0", false},
+			{"\U0001f998", "kangaroo", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "11.0", false},
+			{"\U0001f9a1", "badger", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "11.0", false},
+			{"\U0001f43e", "paw prints", "Animals & Nature", "animal-mammal", []string{"animal", "mammal"}, "0.6", false},
+			{"\U0001f983", "turkey", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "1.0", false},
+			{"\U0001f414", "chicken", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "0.6", false},
+			{"\U0001f413", "rooster", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "1.0", false},
+			{"\U0001f423", "hatching chick", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "0.6", false},
+			{"\U0001f424", "baby chick", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "0.6", false},
+			{"\U0001f425", "front-facing baby chick", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "0.6", false},
+			{"\U0001f426", "bird", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "0.6", false},
+			{"\U0001f427", "penguin", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "0.6", false},
+			{"\U0001f54a\ufe0f", "dove", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "0.7", false},
+			{"\U0001f985", "eagle", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "3.0", false},
+			{"\U0001f986", "duck", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "3.0", false},
+			{"\U0001f9a2", "swan", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "11.0", false},
+			{"\U0001f989", "owl", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "3.0", false},
+			{"\U0001f9a4", "dodo", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "13.0", false},
+			{"\U0001fab6", "feather", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "13.0", false},
+			{"\U0001f9a9", "flamingo", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "12.0", false},
+			{"\U0001f99a", "peacock", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "11.0", false},
+			{"\U0001f99c", "parrot", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "11.0", false},
+			{"\U0001fabd", "wing", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "15.0", false},
+			{"\U0001f426\u200d\u2b1b", "black bird", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "15.0", false},
+			{"\U0001fabf", "goose", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "15.0", false},
+			{"\U0001f426\u200d\U0001f525", "phoenix", "Animals & Nature", "animal-bird", []string{"animal", "bird"}, "15.1", false},
+			{"\U0001f438", "frog", "Animals & Nature", "animal-amphibian", []string{"amphibian", "animal"}, "0.6", false},
+			{"\U0001f40a", "crocodile", "Animals & Nature", "animal-reptile", []string{"animal", "reptile"}, "1.0", false},
+			{"\U0001f422", "turtle", "Animals & Nature", "animal-reptile", []string{"animal", "reptile"}, "0.6", false},
+			{"\U0001f98e", "lizard", "Animals & Nature", "animal-reptile", []string{"animal", "reptile"}, "3.0", false},
+			{"\U0001f40d", "snake", "Animals & Nature", "animal-reptile", []string{"animal", "reptile"}, "0.6", false},
+			{"\U0001f432", "dragon face", "Animals & Nature", "animal-reptile", []string{"animal", "reptile"}, "0.6", false},
+			{"\U0001f409", "dragon", "Animals & Nature", "animal-reptile", []string{"animal", "reptile"}, "1.0", false},
+			{"\U0001f995", "sauropod", "Animals & Nature", "animal-reptile", []string{"animal", "reptile"}, "5.0", false},
+			{"\U0001f996", "T-Rex", "Animals & Nature", "animal-reptile", []string{"animal", "reptile"}, "5.0", false},
+			{"\U0001f433", "spouting whale", "Animals & Nature", "animal-marine", []string{"animal", "marine"}, "0.6", false},
+			{"\U0001f40b", "whale", "Animals & Nature", "animal-marine", []string{"animal", "marine"}, "1.0", false},
+			{"\U0001f42c", "dolphin", "Animals & Nature", "animal-marine", []string{"animal", "marine"}, "0.6", false},
+			{"\U0001f9ad", "seal", "Animals & Nature", "animal-marine", []string{"animal", "marine"}, "13.0", false},
+			{"\U0001f41f", "fish", "Animals & Nature", "animal-marine", []string{"animal", "marine"}, "0.6", false},
+			{"\U0001f420", "tropical fish", "Animals & Nature", "animal-marine", []string{"animal", "marine"}, "0.6", false},
+			{"\U0001f421", "blowfish", "Animals & Nature", "animal-marine", []string{"animal", "marine"}, "0.6", false},
+			{"\U0001f988", "shark", "Animals & Nature", "animal-marine", []string{"animal", "marine"}, "3.0", false},
+			{"\U0001f419", "octopus", "Animals & Nature", "animal-marine", []string{"animal", "marine"}, "0.6", false},
+			{"\U0001f41a", "spiral shell", "Animals & Nature", "animal-marine", []string{"animal", "marine"}, "0.6", false},
+			{"\U0001fab8", "coral", "Animals & Nature", "animal-marine", []string{"animal", "marine"}, "14.0", false},
+			{"\U0001fabc", "jellyfish", "Animals & Nature", "animal-marine", []string{"animal", "marine"}, "15.0", false},
+			{"\U0001f40c", "snail", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "0.6", false},
+			{"\U0001f98b", "butterfly", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "3.0", false},
+			{"\U0001f41b", "bug", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "0.6", false},
+			{"\U0001f41c", "ant", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "0.6", false},
+			{"\U0001f41d", "honeybee", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "0.6", false},
+			{"\U0001fab2", "beetle", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "13.0", false},
+			{"\U0001f41e", "lady beetle", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "0.6", false},
+			{"\U0001f997", "cricket", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "5.0", false},
+			{"\U0001fab3", "cockroach", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "13.0", false},
+			{"\U0001f577\ufe0f", "spider", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "0.7", false},
+			{"\U0001f578\ufe0f", "spider web", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "0.7", false},
+			{"\U0001f982", "scorpion", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "1.0", false},
+			{"\U0001f99f", "mosquito", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "11.0", false},
+			{"\U0001fab0", "fly", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "13.0", false},
+			{"\U0001fab1", "worm", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "13.0", false},
+			{"\U0001f9a0", "microbe", "Animals & Nature", "animal-bug", []string{"animal", "bug"}, "11.0", false},
+			{"\U0001f490", "bouquet", "Animals & Nature", "plant-flower", []string{"flower", "plant"}, "0.6", false},
+			{"\U0001f338", "cherry blossom", "Animals & Nature", "plant-flower", []string{"flower", "plant"}, "0.6", false},
+			{"\U0001f4ae", "white flower", "Animals & Nature", "plant-flower", []string{"flower", "plant"}, "0.6", false},
+			{"\U0001fab7", "lotus", "Animals & Nature", "plant-flower", []string{"flower", "plant"}, "14.0", false},
+			{"\U0001f3f5\ufe0f", "rosette", "Animals & Nature", "plant-flower", []string{"flower", "plant"}, "0.7", false},
+			{"\U0001f339", "rose", "Animals & Nature", "plant-flower", []string{"flower", "plant"}, "0.6", false},
+			{"\U0001f940", "wilted flower", "Animals & Nature", "plant-flower", []string{"flower", "plant"}, "3.0", false},
+			{"\U0001f33a", "hibiscus", "Animals & Nature", "plant-flower", []string{"flower", "plant"}, "0.6", false},
+			{"\U0001f33b", "sunflower", "Animals & Nature", "plant-flower", []string{"flower", "plant"}, "0.6", false},
+			{"\U0001f33c", "blossom", "Animals & Nature", "plant-flower", []string{"flower", "plant"}, "0.6", false},
+			{"\U0001f337", "tulip", "Animals & Nature", "plant-flower", []string{"flower", "plant"}, "0.6", false},
+			{"\U0001fabb", "hyacinth", "Animals & Nature", "plant-flower", []string{"flower", "plant"}, "15.0", false},
+			{"\U0001f331", "seedling", "Animals & Nature", "plant-other", []string{"other", "plant"}, "0.6", false},
+			{"\U0001fab4", "potted plant", "Animals & Nature", "plant-other", []string{"other", "plant"}, "13.0", false},
+			{"\U0001f332", "evergreen tree", "Animals & Nature", "plant-other", []string{"other", "plant"}, "1.0", false},
+			{"\U0001f333", "deciduous tree", "Animals & Nature", "plant-other", []string{"other", "plant"}, "1.0", false},
+			{"\U0001f334", "palm tree", "Animals & Nature", "plant-other", []string{"other", "plant"}, "0.6", false},
+			{"\U0001f335", "cactus", "Animals & Nature", "plant-other", []string{"other", "plant"}, "0.6", false},
+			{"\U0001f33e", "sheaf of rice", "Animals & Nature", "plant-other", []string{"other", "plant"}, "0.6", false},
+			{"\U0001f33f", "herb", "Animals & Nature", "plant-other", []string{"other", "plant"}, "0.6", false},
+			{"\u2618\ufe0f", "shamrock", "Animals & Nature", "plant-other", []string{"other", "plant"}, "1.0", false},
+			{"\U0001f340", "four leaf clover", "Animals & Nature", "plant-other", []string{"other", "plant"}, "0.6", false},
+			{"\U0001f341", "maple leaf", "Animals & Nature", "plant-other", []string{"other", "plant"}, "0.6", false},
+			{"\U0001f342", "fallen leaf", "Animals & Nature", "plant-other", []string{"other", "plant"}, "0.6", false},
+			{"\U0001f343", "leaf fluttering in wind", "Animals & Nature", "plant-other", []string{"other", "plant"}, "0.6", false},
+			{"\U0001fab9", "empty nest", "Animals & Nature", "plant-other", []string{"other", "plant"}, "14.0", false},
+			{"\U0001faba", "nest with eggs", "Animals & Nature", "plant-other", []string{"other", "plant"}, "14.0", false},
+			{"\U0001f344", "mushroom", "Animals & Nature", "plant-other", []string{"other", "plant"}, "0.6", false},
+			{"\U0001f347", "grapes", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "0.6", false},
+			{"\U0001f348", "melon", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "0.6", false},
+			{"\U0001f349", "watermelon", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "0.6", false},
+			{"\U0001f34a", "tangerine", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "0.6", false},
+			{"\U0001f34b", "lemon", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "1.0", false},
+			{"\U0001f34b\u200d\U0001f7e9", "lime", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "15.1", false},
+			{"\U0001f34c", "banana", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "0.6", false},
+			{"\U0001f34d", "pineapple", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "0.6", false},
+			{"\U0001f96d", "mango", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "11.0", false},
+			{"\U0001f34e", "red apple", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "0.6", false},
+			{"\U0001f34f", "green apple", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "0.6", false},
+			{"\U0001f350", "pear", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "1.0", false},
+			{"\U0001f351", "peach", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "0.6", false},
+			{"\U0001f352", "cherries", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "0.6", false},
+			{"\U0001f353", "strawberry", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "0.6", false},
+			{"\U0001fad0", "blueberries", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "13.0", false},
+			{"\U0001f95d", "kiwi fruit", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "3.0", false},
+			{"\U0001f345", "tomato", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "0.6", false},
+			{"\U0001fad2", "olive", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "13.0", false},
+			{"\U0001f965", "coconut", "Food & Drink", "food-fruit", []string{"food", "fruit"}, "5.0", false},
+			{"\U0001f951", "avocado", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "3.0", false},
+			{"\U0001f346", "eggplant", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "0.6", false},
+			{"\U0001f954", "potato", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "3.0", false},
+			{"\U0001f955", "carrot", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "3.0", false},
+			{"\U0001f33d", "ear of corn", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "0.6", false},
+			{"\U0001f336\ufe0f", "hot pepper", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "0.7", false},
+			{"\U0001fad1", "bell pepper", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "13.0", false},
+			{"\U0001f952", "cucumber", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "3.0", false},
+			{"\U0001f96c", "leafy green", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "11.0", false},
+			{"\U0001f966", "broccoli", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "5.0", false},
+			{"\U0001f9c4", "garlic", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "12.0", false},
+			{"\U0001f9c5", "onion", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "12.0", false},
+			{"\U0001f95c", "peanuts", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "3.0", false},
+			{"\U0001fad8", "beans", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "14.0", false},
+			{"\U0001f330", "chestnut", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "0.6", false},
+			{"\U0001fada", "ginger root", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "15.0", false},
+			{"\U0001fadb", "pea pod", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "15.0", false},
+			{"\U0001f344\u200d\U0001f7eb", "brown mushroom", "Food & Drink", "food-vegetable", []string{"food", "vegetable"}, "15.1", false},
+			{"\U0001f35e", "bread", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "0.6", false},
+			{"\U0001f950", "croissant", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "3.0", false},
+			{"\U0001f956", "baguette bread", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "3.0", false},
+			{"\U0001fad3", "flatbread", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "13.0", false},
+			{"\U0001f968", "pretzel", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "5.0", false},
+			{"\U0001f96f", "bagel", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "11.0", false},
+			{"\U0001f95e", "pancakes", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "3.0", false},
+			{"\U0001f9c7", "waffle", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "12.0", false},
+			{"\U0001f9c0", "cheese wedge", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "1.0", false},
+			{"\U0001f356", "meat on bone", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "0.6", false},
+			{"\U0001f357", "poultry leg", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "0.6", false},
+			{"\U0001f969", "cut of meat", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "5.0", false},
+			{"\U0001f953", "bacon", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "3.0", false},
+			{"\U0001f354", "hamburger", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "0.6", false},
+			{"\U0001f35f", "french fries", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "0.6", false},
+			{"\U0001f355", "pizza", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "0.6", false},
+			{"\U0001f32d", "hot dog", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "1.0", false},
+			{"\U0001f96a", "sandwich", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "5.0", false},
+			{"\U0001f32e", "taco", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "1.0", false},
+			{"\U0001f32f", "burrito", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "1.0", false},
+			{"\U0001fad4", "tamale", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "13.0", false},
+			{"\U0001f959", "stuffed flatbread", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "3.0", false},
+			{"\U0001f9c6", "falafel", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "12.0", false},
+			{"\U0001f95a", "egg", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "3.0", false},
+			{"\U0001f373", "cooking", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "0.6", false},
+			{"\U0001f958", "shallow pan of food", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "3.0", false},
+			{"\U0001f372", "pot of food", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "0.6", false},
+			{"\U0001fad5", "fondue", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "13.0", false},
+			{"\U0001f963", "bowl with spoon", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "5.0", false},
+			{"\U0001f957", "green salad", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "3.0", false},
+			{"\U0001f37f", "popcorn", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "1.0", false},
+			{"\U0001f9c8", "butter", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "12.0", false},
+			{"\U0001f9c2", "salt", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "11.0", false},
+			{"\U0001f96b", "canned food", "Food & Drink", "food-prepared", []string{"food", "prepared"}, "5.0", false},
+			{"\U0001f371", "bento box", "Food & Drink", "food-asian", []string{"asian", "food"}, "0.6", false},
+			{"\U0001f358", "rice cracker", "Food & Drink", "food-asian", []string{"asian", "food"}, "0.6", false},
+			{"\U0001f359", "rice ball", "Food & Drink", "food-asian", []string{"asian", "food"}, "0.6", false},
+			{"\U0001f35a", "cooked rice", "Food & Drink", "food-asian", []string{"asian", "food"}, "0.6", false},
+			{"\U0001f35b", "curry rice", "Food & Drink", "food-asian", []string{"asian", "food"}, "0.6", false},
+			{"\U0001f35c", "steaming bowl", "Food & Drink", "food-asian", []string{"asian", "food"}, "0.6", false},
+			{"\U0001f35d", "spaghetti", "Food & Drink", "food-asian", []string{"asian", "food"}, "0.6", false},
+			{"\U0001f360", "roasted sweet potato", "Food & Drink", "food-asian", []string{"asian", "food"}, "0.6", false},
+			{"\U0001f362", "oden", "Food & Drink", "food-asian", []string{"asian", "food"}, "0.6", false},
+			{"\U0001f363", "sushi", "Food & Drink", "food-asian", []string{"asian", "food"}, "0.6", false},
+			{"\U0001f364", "fried shrimp", "Food & Drink", "food-asian", []string{"asian", "food"}, "0.6", false},
+			{"\U0001f365", "fish cake with swirl", "Food & Drink", "food-asian", []string{"asian", "food"}, "0.6", false},
+			{"\U0001f96e", "moon cake", "Food & Drink", "food-asian", []string{"asian", "food"}, "11.0", false},
+			{"\U0001f361", "dango", "Food & Drink", "food-asian", []string{"asian", "food"}, "0.6", false},
+			{"\U0001f95f", "dumpling", "Food & Drink", "food-asian", []string{"asian", "food"}, "5.0", false},
+			{"\U0001f960", "fortune cookie", "Food & Drink", "food-asian", []string{"asian", "food"}, "5.0", false},
+			{"\U0001f961", "takeout box", "Food & Drink", "food-asian", []string{"asian", "food"}, "5.0", false},
+			{"\U0001f980", "crab", "Food & Drink", "food-marine", []string{"food", "marine"}, "1.0", false},
+			{"\U0001f99e", "lobster", "Food & Drink", "food-marine", []string{"food", "marine"}, "11.0", false},
+			{"\U0001f990", "shrimp", "Food & Drink", "food-marine", []string{"food", "marine"}, "3.0", false},
+			{"\U0001f991", "squid", "Food & Drink", "food-marine", []string{"food", "marine"}, "3.0", false},
+			{"\U0001f9aa", "oyster", "Food & Drink", "food-marine", []string{"food", "marine"}, "12.0", false},
+			{"\U0001f366", "soft ice cream", "Food & Drink", "food-sweet", []string{"food", "sweet"}, "0.6", false},
+			{"\U0001f367", "shaved ice", "Food & Drink", "food-sweet", []string{"food", "sweet"}, "0.6", false},
+			{"\U0001f368", "ice cream", "Food & Drink", "food-sweet", []string{"food", "sweet"}, "0.6", false},
+			{"\U0001f369", "doughnut", "Food & Drink", "food-sweet", []string{"food", "sweet"}, "0.6", false},
+			{"\U0001f36a", "cookie", "Food & Drink", "food-sweet", []string{"food", "sweet"}, "0.6", false},
+			{"\U0001f382", "birthday cake", "Food & Drink", "food-sweet", []string{"food", "sweet"}, "0.6", false},
+			{"\U0001f370", "shortcake", "Food & Drink", "food-sweet", []string{"food", "sweet"}, "0.6", false},
+			{"\U0001f9c1", "cupcake", "Food & Drink", "food-sweet", []string{"food", "sweet"}, "11.0", false},
+			{"\U0001f967", "pie", "Food & Drink", "food-sweet", []string{"food", "sweet"}, "5.0", false},
+			{"\U0001f36b", "chocolate bar", "Food & Drink", "food-sweet", []string{"food", "sweet"}, "0.6", false},
+			{"\U0001f36c", "candy", "Food & Drink", "food-sweet", []string{"food", "sweet"}, "0.6", false},
+			{"\U0001f36d", "lollipop", "Food & Drink", "food-sweet", []string{"food", "sweet"}, "0.6", false},
+			{"\U0001f36e", "custard", "Food & Drink", "food-sweet", []string{"food", "sweet"}, "0.6", false},
+			{"\U0001f36f", "honey pot", "Food & Drink", "food-sweet", []string{"food", "sweet"}, "0.6", false},
+			{"\U0001f37c", "baby bottle", "Food & Drink", "drink", []string{"drink"}, "1.0", false},
+			{"\U0001f95b", "glass of milk", "Food & Drink", "drink", []string{"drink"}, "3.0", false},
+			{"\u2615", "hot beverage", "Food & Drink", "drink", []string{"drink"}, "0.6", false},
+			{"\U0001fad6", "teapot", "Food & Drink", "drink", []string{"drink"}, "13.0", false},
+			{"\U0001f375", "teacup without handle", "Food & Drink", "drink", []string{"drink"}, "0.6", false},
+			{"\U0001f376", "sake", "Food & Drink", "drink", []string{"drink"}, "0.6", false},
+			{"\U0001f37e", "bottle with popping cork", "Food & Drink", "drink", []string{"drink"}, "1.0", false},
+			{"\U0001f377", "wine glass", "Food & Drink", "drink", []string{"drink"}, "0.6", false},
+			{"\U0001f378", "cocktail glass", "Food & Drink", "drink", []string{"drink"}, "0.6", false},
+			{"\U0001f379", "tropical drink", "Food & Drink", "drink", []string{"drink"}, "0.6", false},
+			{"\U0001f37a", "beer mug", "Food & Drink", "drink", []string{"drink"}, "0.6", false},
+			{"\U0001f37b", "clinking beer mugs", "Food & Drink", "drink", []string{"drink"}, "0.6", false},
+			{"\U0001f942", "clinking glasses", "Food & Drink", "drink", []string{"drink"}, "3.0", false},
+			{"\U0001f943", "tumbler glass", "Food & Drink", "drink", []string{"drink"}, "3.0", false},
+			{"\U0001fad7", "pouring liquid", "Food & Drink", "drink", []string{"drink"}, "14.0", false},
+			{"\U0001f964", "cup with straw", "Food & Drink", "drink", []string{"drink"}, "5.0", false},
+			{"\U0001f9cb", "bubble tea", "Food & Drink", "drink", []string{"drink"}, "13.0", false},
+			{"\U0001f9c3", "beverage box", "Food & Drink", "drink", []string{"drink"}, "12.0", false},
+			{"\U0001f9c9", "mate", "Food & Drink", "drink", []string{"drink"}, "12.0", false},
+			{"\U0001f9ca", "ice", "Food & Drink", "drink", []string{"drink"}, "12.0", false},
+			{"\U0001f962", "chopsticks", "Food & Drink", "dishware", []string{"dishware"}, "5.0", false},
+			{"\U0001f37d\ufe0f", "fork and knife with plate", "Food & Drink", "dishware", []string{"dishware"}, "0.7", false},
+			{"\U0001f374", "fork and knife", "Food & Drink", "dishware", []string{"dishware"}, "0.6", false},
+			{"\U0001f944", "spoon", "Food & Drink", "dishware", []string{"dishware"}, "3.0", false},
+			{"\U0001f52a", "kitchen knife", "Food & Drink", "dishware", []string{"dishware"}, "0.6", false},
+			{"\U0001fad9", "jar", "Food & Drink", "dishware", []string{"dishware"}, "14.0", false},
+			{"\U0001f3fa", "amphora", "Food & Drink", "dishware", []string{"dishware"}, "1.0", false},
+			{"\U0001f30d", "globe showing Europe-Africa", "Travel & Places", "place-map", []string{"map", "place"}, "0.7", false},
+			{"\U0001f30e", "globe showing Americas", "Travel & Places", "place-map", []string{"map", "place"}, "0.7", false},
+			{"\U0001f30f", "globe showing Asia-Australia", "Travel & Places", "place-map", []string{"map", "place"}, "0.6", false},
+			{"\U0001f310", "globe with meridians", "Travel & Places", "place-map", []string{"map", "place"}, "1.0", false},
+			{"\U0001f5fa\ufe0f", "world map", "Travel & Places", "place-map", []string{"map", "place"}, "0.7", false},
+			{"\U0001f5fe", "map of Japan", "Travel & Places", "place-map", []string{"map", "place"}, "0.6", false},
+			{"\U0001f9ed", "compass", "Travel & Places", "place-map", []string{"map", "place"}, "11.0", false},
+			{"\U0001f3d4\ufe0f", "snow-capped mountain", "Travel & Places", "place-geographic", []string{"geographic", "place"}, "0.7", false},
+			{"\u26f0\ufe0f", "mountain", "Travel & Places", "place-geographic", []string{"geographic", "place"}, "0.7", false},
+			{"\U0001f30b", "volcano", "Travel & Places", "place-geographic", []string{"geographic", "place"}, "0.6", false},
+			{"\U0001f5fb", "mount fuji", "Travel & Places", "place-geographic", []string{"geographic", "place"}, "0.6", false},
+			{"\U0001f3d5\ufe0f", "camping", "Travel & Places", "place-geographic", []string{"geographic", "place"}, "0.7", false},
+			{"\U0001f3d6\ufe0f", "beach with umbrella", "Travel & Places", "place-geographic", []string{"geographic", "place"}, "0.7", false},
+			{"\U0001f3dc\ufe0f", "desert", "Travel & Places", "place-geographic", []string{"geographic", "place"}, "0.7", false},
+			{"\U0001f3dd\ufe0f", "desert island", "Travel & Places", "place-geographic", []string{"geographic", "place"}, "0.7", false},
+			{"\U0001f3de\ufe0f", "national park", "Travel & Places", "place-geographic", []string{"geographic", "place"}, "0.7", false},
+			{"\U0001f3df\ufe0f", "stadium", "Travel & Places", "place-building", []string{"building", "place"}, "0.7", false},
+			{"\U0001f3db\ufe0f", "classical building", "Travel & Places", "place-building", []string{"building", "place"}, "0.7", false},
+			{"\U0001f3d7\ufe0f", "building construction", "Travel & Places", "place-building", []string{"building", "place"}, "0.7", false},
+			{"\U0001f9f1", "brick", "Travel & Places", "place-building", []string{"building", "place"}, "11.0", false},
+			{"\U0001faa8", "rock", "Travel & Places", "place-building", []string{"building", "place"}, "13.0", false},
+			{"\U0001fab5", "wood", "Travel & Places", "place-building", []string{"building", "place"}, "13.0", false},
+			{"\U0001f6d6", "hut", "Travel & Places", "place-building", []string{"building", "place"}, "13.0", false},
+			{"\U0001f3d8\ufe0f", "houses", "Travel & Places", "place-building", []string{"building", "place"}, "0.7", false},
+			{"\U0001f3da\ufe0f", "derelict house", "Travel & Places", "place-building", []string{"building", "place"}, "0.7", false},
+			{"\U0001f3e0", "house", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f3e1", "house with garden", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f3e2", "office building", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f3e3", "Japanese post office", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f3e4", "post office", "Travel & Places", "place-building", []string{"building", "place"}, "1.0", false},
+			{"\U0001f3e5", "hospital", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f3e6", "bank", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f3e8", "hotel", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f3e9", "love hotel", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f3ea", "convenience store", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f3eb", "school", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f3ec", "department store", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f3ed", "factory", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f3ef", "Japanese castle", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f3f0", "castle", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f492", "wedding", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f5fc", "Tokyo tower", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\U0001f5fd", "Statue of Liberty", "Travel & Places", "place-building", []string{"building", "place"}, "0.6", false},
+			{"\u26ea", "church", "Travel & Places", "place-religious", []string{"place", "religious"}, "0.6", false},
+			{"\U0001f54c", "mosque", "Travel & Places", "place-religious", []string{"place", "religious"}, "1.0", false},
+			{"\U0001f6d5", "hindu temple", "Travel & Places", "place-religious", []string{"place", "religious"}, "12.0", false},
+			{"\U0001f54d", "synagogue", "Travel & Places", "place-religious", []string{"place", "religious"}, "1.0", false},
+			{"\u26e9\ufe0f", "shinto shrine", "Travel & Places", "place-religious", []string{"place", "religious"}, "0.7", false},
+			{"\U0001f54b", "kaaba", "Travel & Places", "place-religious", []string{"place", "religious"}, "1.0", false},
+			{"\u26f2", "fountain", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\u26fa", "tent", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\U0001f301", "foggy", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\U0001f303", "night with stars", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\U0001f3d9\ufe0f", "cityscape", "Travel & Places", "place-other", []string{"other", "place"}, "0.7", false},
+			{"\U0001f304", "sunrise over mountains", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\U0001f305", "sunrise", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\U0001f306", "cityscape at dusk", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\U0001f307", "sunset", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\U0001f309", "bridge at night", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\u2668\ufe0f", "hot springs", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\U0001f3a0", "carousel horse", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\U0001f6dd", "playground slide", "Travel & Places", "place-other", []string{"other", "place"}, "14.0", false},
+			{"\U0001f3a1", "ferris wheel", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\U0001f3a2", "roller coaster", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\U0001f488", "barber pole", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\U0001f3aa", "circus tent", "Travel & Places", "place-other", []string{"other", "place"}, "0.6", false},
+			{"\U0001f682", "locomotive", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "1.0", false},
+			{"\U0001f683", "railway car", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f684", "high-speed train", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f685", "bullet train", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f686", "train", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "1.0", false},
+			{"\U0001f687", "metro", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f688", "light rail", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "1.0", false},
+			{"\U0001f689", "station", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f68a", "tram", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "1.0", false},
+			{"\U0001f69d", "monorail", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "1.0", false},
+			{"\U0001f69e", "mountain railway", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "1.0", false},
+			{"\U0001f68b", "tram car", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "1.0", false},
+			{"\U0001f68c", "bus", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f68d", "oncoming bus", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.7", false},
+			{"\U0001f68e", "trolleybus", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "1.0", false},
+			{"\U0001f690", "minibus", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "1.0", false},
+			{"\U0001f691", "ambulance", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f692", "fire engine", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f693", "police car", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f694", "oncoming police car", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.7", false},
+			{"\U0001f695", "taxi", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f696", "oncoming taxi", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "1.0", false},
+			{"\U0001f697", "automobile", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f698", "oncoming automobile", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.7", false},
+			{"\U0001f699", "sport utility vehicle", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f6fb", "pickup truck", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "13.0", false},
+			{"\U0001f69a", "delivery truck", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f69b", "articulated lorry", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "1.0", false},
+			{"\U0001f69c", "tractor", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "1.0", false},
+			{"\U0001f3ce\ufe0f", "racing car", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.7", false},
+			{"\U0001f3cd\ufe0f", "motorcycle", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.7", false},
+			{"\U0001f6f5", "motor scooter", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "3.0", false},
+			{"\U0001f9bd", "manual wheelchair", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "12.0", false},
+			{"\U0001f9bc", "motorized wheelchair", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "12.0", false},
+			{"\U0001f6fa", "auto rickshaw", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "12.0", false},
+			{"\U0001f6b2", "bicycle", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f6f4", "kick scooter", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "3.0", false},
+			{"\U0001f6f9", "skateboard", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "11.0", false},
+			{"\U0001f6fc", "roller skate", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "13.0", false},
+			{"\U0001f68f", "bus stop", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f6e3\ufe0f", "motorway", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.7", false},
+			{"\U0001f6e4\ufe0f", "railway track", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.7", false},
+			{"\U0001f6e2\ufe0f", "oil drum", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.7", false},
+			{"\u26fd", "fuel pump", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f6de", "wheel", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "14.0", false},
+			{"\U0001f6a8", "police car light", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f6a5", "horizontal traffic light", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\U0001f6a6", "vertical traffic light", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "1.0", false},
+			{"\U0001f6d1", "stop sign", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "3.0", false},
+			{"\U0001f6a7", "construction", "Travel & Places", "transport-ground", []string{"ground", "transport"}, "0.6", false},
+			{"\u2693", "anchor", "Travel & Places", "transport-water", []string{"transport", "water"}, "0.6", false},
+			{"\U0001f6df", "ring buoy", "Travel & Places", "transport-water", []string{"transport", "water"}, "14.0", false},
+			{"\u26f5", "sailboat", "Travel & Places", "transport-water", []string{"transport", "water"}, "0.6", false},
+			{"\U0001f6f6", "canoe", "Travel & Places", "transport-water", []string{"transport", "water"}, "3.0", false},
+			{"\U0001f6a4", "speedboat", "Travel & Places", "transport-water", []string{"transport", "water"}, "0.6", false},
+			{"\U0001f6f3\ufe0f", "passenger ship", "Travel & Places", "transport-water", []string{"transport", "water"}, "0.7", false},
+			{"\u26f4\ufe0f", "ferry", "Travel & Places", "transport-water", []string{"transport", "water"}, "0.7", false},
+			{"\U0001f6e5\ufe0f", "motor boat", "Travel & Places", "transport-water", []string{"transport", "water"}, "0.7", false},
+			{"\U0001f6a2", "ship", "Travel & Places", "transport-water", []string{"transport", "water"}, "0.6", false},
+			{"\u2708\ufe0f", "airplane", "Travel & Places", "transport-air", []string{"air", "transport"}, "0.6", false},
+			{"\U0001f6e9\ufe0f", "small airplane", "Travel & Places", "transport-air", []string{"air", "transport"}, "0.7", false},
+			{"\U0001f6eb", "airplane departure", "Travel & Places", "transport-air", []string{"air", "transport"}, "1.0", false},
+			{"\U0001f6ec", "airplane arrival", "Travel & Places", "transport-air", []string{"air", "transport"}, "1.0", false},
+			{"\U0001fa82", "parachute", "Travel & Places", "transport-air", []string{"air", "transport"}, "12.0", false},
+			{"\U0001f4ba", "seat", "Travel & Places", "transport-air", []string{"air", "transport"}, "0.6", false},
+			{"\U0001f681", "helicopter", "Travel & Places", "transport-air", []string{"air", "transport"}, "1.0", false},
+			{"\U0001f69f", "suspension railway", "Travel & Places", "transport-air", []string{"air", "transport"}, "1.0", false},
+			{"\U0001f6a0", "mountain cableway", "Travel & Places", "transport-air", []string{"air", "transport"}, "1.0", false},
+			{"\U0001f6a1", "aerial tramway", "Travel & Places", "transport-air", []string{"air", "transport"}, "1.0", false},
+			{"\U0001f6f0\ufe0f", "satellite", "Travel & Places", "transport-air", []string{"air", "transport"}, "0.7", false},
+			{"\U0001f680", "rocket", "Travel & Places", "transport-air", []string{"air", "transport"}, "0.6", false},
+			{"\U0001f6f8", "flying saucer", "Travel & Places", "transport-air", []string{"air", "transport"}, "5.0", false},
+			{"\U0001f6ce\ufe0f", "bellhop bell", "Travel & Places", "hotel", []string{"hotel"}, "0.7", false},
+			{"\U0001f9f3", "luggage", "Travel & Places", "hotel", []string{"hotel"}, "11.0", false},
+			{"\u231b", "hourglass done", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\u23f3", "hourglass not done", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\u231a", "watch", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\u23f0", "alarm clock", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\u23f1\ufe0f", "stopwatch", "Travel & Places", "time", []string{"time"}, "1.0", false},
+			{"\u23f2\ufe0f", "timer clock", "Travel & Places", "time", []string{"time"}, "1.0", false},
+			{"\U0001f570\ufe0f", "mantelpiece clock", "Travel & Places", "time", []string{"time"}, "0.7", false},
+			{"\U0001f55b", "twelve o’clock", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\U0001f567", "twelve-thirty", "Travel & Places", "time", []string{"time"}, "0.7", false},
+			{"\U0001f550", "one o’clock", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\U0001f55c", "one-thirty", "Travel & Places", "time", []string{"time"}, "0.7", false},
+			{"\U0001f551", "two o’clock", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\U0001f55d", "two-thirty", "Travel & Places", "time", []string{"time"}, "0.7", false},
+			{"\U0001f552", "three o’clock", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\U0001f55e", "three-thirty", "Travel & Places", "time", []string{"time"}, "0.7", false},
+			{"\U0001f553", "four o’clock", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\U0001f55f", "four-thirty", "Travel & Places", "time", []string{"time"}, "0.7", false},
+			{"\U0001f554", "five o’clock", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\U0001f560", "five-thirty", "Travel & Places", "time", []string{"time"}, "0.7", false},
+			{"\U0001f555", "six o’clock", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\U0001f561", "six-thirty", "Travel & Places", "time", []string{"time"}, "0.7", false},
+			{"\U0001f556", "seven o’clock", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\U0001f562", "seven-thirty", "Travel & Places", "time", []string{"time"}, "0.7", false},
+			{"\U0001f557", "eight o’clock", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\U0001f563", "eight-thirty", "Travel & Places", "time", []string{"time"}, "0.7", false},
+			{"\U0001f558", "nine o’clock", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\U0001f564", "nine-thirty", "Travel & Places", "time", []string{"time"}, "0.7", false},
+			{"\U0001f559", "ten o’clock", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\U0001f565", "ten-thirty", "Travel & Places", "time", []string{"time"}, "0.7", false},
+			{"\U0001f55a", "eleven o’clock", "Travel & Places", "time", []string{"time"}, "0.6", false},
+			{"\U0001f566", "eleven-thirty", "Travel & Places", "time", []string{"time"}, "0.7", false},
+			{"\U0001f311", "new moon", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f312", "waxing crescent moon", "Travel & Places", "sky & weather", []string{"sky & weather"}, "1.0", false},
+			{"\U0001f313", "first quarter moon", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f314", "waxing gibbous moon", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f315", "full moon", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f316", "waning gibbous moon", "Travel & Places", "sky & weather", []string{"sky & weather"}, "1.0", false},
+			{"\U0001f317", "last quarter moon", "Travel & Places", "sky & weather", []string{"sky & weather"}, "1.0", false},
+			{"\U0001f318", "waning crescent moon", "Travel & Places", "sky & weather", []string{"sky & weather"}, "1.0", false},
+			{"\U0001f319", "crescent moon", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f31a", "new moon face", "Travel & Places", "sky & weather", []string{"sky & weather"}, "1.0", false},
+			{"\U0001f31b", "first quarter moon face", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f31c", "last quarter moon face", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\U0001f321\ufe0f", "thermometer", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\u2600\ufe0f", "sun", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f31d", "full moon face", "Travel & Places", "sky & weather", []string{"sky & weather"}, "1.0", false},
+			{"\U0001f31e", "sun with face", "Travel & Places", "sky & weather", []string{"sky & weather"}, "1.0", false},
+			{"\U0001fa90", "ringed planet", "Travel & Places", "sky & weather", []string{"sky & weather"}, "12.0", false},
+			{"\u2b50", "star", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f31f", "glowing star", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f320", "shooting star", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f30c", "milky way", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\u2601\ufe0f", "cloud", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\u26c5", "sun behind cloud", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\u26c8\ufe0f", "cloud with lightning and rain", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\U0001f324\ufe0f", "sun behind small cloud", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\U0001f325\ufe0f", "sun behind large cloud", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\U0001f326\ufe0f", "sun behind rain cloud", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\U0001f327\ufe0f", "cloud with rain", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\U0001f328\ufe0f", "cloud with snow", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\U0001f329\ufe0f", "cloud with lightning", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\U0001f32a\ufe0f", "tornado", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\U0001f32b\ufe0f", "fog", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\U0001f32c\ufe0f", "wind face", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\U0001f300", "cyclone", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f308", "rainbow", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f302", "closed umbrella", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\u2602\ufe0f", "umbrella", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\u2614", "umbrella with rain drops", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\u26f1\ufe0f", "umbrella on ground", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\u26a1", "high voltage", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\u2744\ufe0f", "snowflake", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\u2603\ufe0f", "snowman", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.7", false},
+			{"\u26c4", "snowman without snow", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\u2604\ufe0f", "comet", "Travel & Places", "sky & weather", []string{"sky & weather"}, "1.0", false},
+			{"\U0001f525", "fire", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f4a7", "droplet", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f30a", "water wave", "Travel & Places", "sky & weather", []string{"sky & weather"}, "0.6", false},
+			{"\U0001f383", "jack-o-lantern", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f384", "Christmas tree", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f386", "fireworks", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f387", "sparkler", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f9e8", "firecracker", "Activities", "event", []string{"event"}, "11.0", false},
+			{"\u2728", "sparkles", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f388", "balloon", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f389", "party popper", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f38a", "confetti ball", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f38b", "tanabata tree", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f38d", "pine decoration", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f38e", "Japanese dolls", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f38f", "carp streamer", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f390", "wind chime", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f391", "moon viewing ceremony", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f9e7", "red envelope", "Activities", "event", []string{"event"}, "11.0", false},
+			{"\U0001f380", "ribbon", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f381", "wrapped gift", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f397\ufe0f", "reminder ribbon", "Activities", "event", []string{"event"}, "0.7", false},
+			{"\U0001f39f\ufe0f", "admission tickets", "Activities", "event", []string{"event"}, "0.7", false},
+			{"\U0001f3ab", "ticket", "Activities", "event", []string{"event"}, "0.6", false},
+			{"\U0001f396\ufe0f", "military medal", "Activities", "award-medal", []string{"award", "medal"}, "0.7", false},
+			{"\U0001f3c6", "trophy", "Activities", "award-medal", []string{"award", "medal"}, "0.6", false},
+			{"\U0001f3c5", "sports medal", "Activities", "award-medal", []string{"award", "medal"}, "1.0", false},
+			{"\U0001f947", "1st place medal", "Activities", "award-medal", []string{"award", "medal"}, "3.0", false},
+			{"\U0001f948", "2nd place medal", "Activities", "award-medal", []string{"award", "medal"}, "3.0", false},
+			{"\U0001f949", "3rd place medal", "Activities", "award-medal", []string{"award", "medal"}, "3.0", false},
+			{"\u26bd", "soccer ball", "Activities", "sport", []string{"sport"}, "0.6", false},
+			{"\u26be", "baseball", "Activities", "sport", []string{"sport"}, "0.6", false},
+			{"\U0001f94e", "softball", "Activities", "sport", []string{"sport"}, "11.0", false},
+			{"\U0001f3c0", "basketball", "Activities", "sport", []string{"sport"}, "0.6", false},
+			{"\U0001f3d0", "volleyball", "Activities", "sport", []string{"sport"}, "1.0", false},
+			{"\U0001f3c8", "american football", "Activities", "sport", []string{"sport"}, "0.6", false},
+			{"\U0001f3c9", "rugby football", "Activities", "sport", []string{"sport"}, "1.0", false},
+			{"\U0001f3be", "tennis", "Activities", "sport", []string{"sport"}, "0.6", false},
+			{"\U0001f94f", "flying disc", "Activities", "sport", []string{"sport"}, "11.0", false},
+			{"\U0001f3b3", "bowling", "Activities", "sport", []string{"sport"}, "0.6", false},
+			{"\U0001f3cf", "cricket game", "Activities", "sport", []string{"sport"}, "1.0", false},
+			{"\U0001f3d1", "field hockey", "Activities", "sport", []string{"sport"}, "1.0", false},
+			{"\U0001f3d2", "ice hockey", "Activities", "sport", []string{"sport"}, "1.0", false},
+			{"\U0001f94d", "lacrosse", "Activities", "sport", []string{"sport"}, "11.0", false},
+			{"\U0001f3d3", "ping pong", "Activities", "sport", []string{"sport"}, "1.0", false},
+			{"\U0001f3f8", "badminton", "Activities", "sport", []string{"sport"}, "1.0", false},
+			{"\U0001f94a", "boxing glove", "Activities", "sport", []string{"sport"}, "3.0", false},
+			{"\U0001f94b", "martial arts uniform", "Activities", "sport", []string{"sport"}, "3.0", false},
+			{"\U0001f945", "goal net", "Activities", "sport", []string{"sport"}, "3.0", false},
+			{"\u26f3", "flag in hole", "Activities", "sport", []string{"sport"}, "0.6", false},
+			{"\u26f8\ufe0f", "ice skate", "Activities", "sport", []string{"sport"}, "0.7", false},
+			{"\U0001f3a3", "fishing pole", "Activities", "sport", []string{"sport"}, "0.6", false},
+			{"\U0001f93f", "diving mask", "Activities", "sport", []string{"sport"}, "12.0", false},
+			{"\U0001f3bd", "running shirt", "Activities", "sport", []string{"sport"}, "0.6", false},
+			{"\U0001f3bf", "skis", "Activities", "sport", []string{"sport"}, "0.6", false},
+			{"\U0001f6f7", "sled", "Activities", "sport", []string{"sport"}, "5.0", false},
+			{"\U0001f94c", "curling stone", "Activities", "sport", []string{"sport"}, "5.0", false},
+			{"\U0001f3af", "bullseye", "Activities", "game", []string{"game"}, "0.6", false},
+			{"\U0001fa80", "yo-yo", "Activities", "game", []string{"game"}, "12.0", false},
+			{"\U0001fa81", "kite", "Activities", "game", []string{"game"}, "12.0", false},
+			{"\U0001f52b", "water pistol", "Activities", "game", []string{"game"}, "0.6", false},
+			{"\U0001f3b1", "pool 8 ball", "Activities", "game", []string{"game"}, "0.6", false},
+			{"\U0001f52e", "crystal ball", "Activities", "game", []string{"game"}, "0.6", false},
+			{"\U0001fa84", "magic wand", "Activities", "game", []string{"game"}, "13.0", false},
+			{"\U0001f3ae", "video game", "Activities", "game", []string{"game"}, "0.6", false},
+			{"\U0001f579\ufe0f", "joystick", "Activities", "game", []string{"game"}, "0.7", false},
+			{"\U0001f3b0", "slot machine", "Activities", "game", []string{"game"}, "0.6", false},
+			{"\U0001f3b2", "game die", "Activities", "game", []string{"game"}, "0.6", false},
+			{"\U0001f9e9", "puzzle piece", "Activities", "game", []string{"game"}, "11.0", false},
+			{"\U0001f9f8", "teddy bear", "Activities", "game", []string{"game"}, "11.0", false},
+			{"\U0001fa85", "piñata", "Activities", "game", []string{"game"}, "13.0", false},
+			{"\U0001faa9", "mirror ball", "Activities", "game", []string{"game"}, "14.0", false},
+			{"\U0001fa86", "nesting dolls", "Activities", "game", []string{"game"}, "13.0", false},
+			{"\u2660\ufe0f", "spade suit", "Activities", "game", []string{"game"}, "0.6", false},
+			{"\u2665\ufe0f", "heart suit", "Activities", "game", []string{"game"}, "0.6", false},
+			{"\u2666\ufe0f", "diamond suit", "Activities", "game", []string{"game"}, "0.6", false},
+			{"\u2663\ufe0f", "club suit", "Activities", "game", []string{"game"}, "0.6", false},
+			{"\u265f\ufe0f", "chess pawn", "Activities", "game", []string{"game"}, "11.0", false},
+			{"\U0001f0cf", "joker", "Activities", "game", []string{"game"}, "0.6", false},
+			{"\U0001f004", "mahjong red dragon", "Activities", "game", []string{"game"}, "0.6", false},
+			{"\U0001f3b4", "flower playing cards", "Activities", "game", []string{"game"}, "0.6", false},
+			{"\U0001f3ad", "performing arts", "Activities", "arts & crafts", []string{"arts & crafts"}, "0.6", false},
+			{"\U0001f5bc\ufe0f", "framed picture", "Activities", "arts & crafts", []string{"arts & crafts"}, "0.7", false},
+			{"\U0001f3a8", "artist palette", "Activities", "arts & crafts", []string{"arts & crafts"}, "0.6", false},
+			{"\U0001f9f5", "thread", "Activities", "arts & crafts", []string{"arts & crafts"}, "11.0", false},
+			{"\U0001faa1", "sewing needle", "Activities", "arts & crafts", []string{"arts & crafts"}, "13.0", false},
+			{"\U0001f9f6", "yarn", "Activities", "arts & crafts", []string{"arts & crafts"}, "11.0", false},
+			{"\U0001faa2", "knot", "Activities", "arts & crafts", []string{"arts & crafts"}, "13.0", false},
+			{"\U0001f453", "glasses", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f576\ufe0f", "sunglasses", "Objects", "clothing", []string{"clothing"}, "0.7", false},
+			{"\U0001f97d", "goggles", "Objects", "clothing", []string{"clothing"}, "11.0", false},
+			{"\U0001f97c", "lab coat", "Objects", "clothing", []string{"clothing"}, "11.0", false},
+			{"\U0001f9ba", "safety vest", "Objects", "clothing", []string{"clothing"}, "12.0", false},
+			{"\U0001f454", "necktie", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f455", "t-shirt", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f456", "jeans", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f9e3", "scarf", "Objects", "clothing", []string{"clothing"}, "5.0", false},
+			{"\U0001f9e4", "gloves", "Objects", "clothing", []string{"clothing"}, "5.0", false},
+			{"\U0001f9e5", "coat", "Objects", "clothing", []string{"clothing"}, "5.0", false},
+			{"\U0001f9e6", "socks", "Objects", "clothing", []string{"clothing"}, "5.0", false},
+			{"\U0001f457", "dress", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f458", "kimono", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f97b", "sari", "Objects", "clothing", []string{"clothing"}, "12.0", false},
+			{"\U0001fa71", "one-piece swimsuit", "Objects", "clothing", []string{"clothing"}, "12.0", false},
+			{"\U0001fa72", "briefs", "Objects", "clothing", []string{"clothing"}, "12.0", false},
+			{"\U0001fa73", "shorts", "Objects", "clothing", []string{"clothing"}, "12.0", false},
+			{"\U0001f459", "bikini", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f45a", "woman’s clothes", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001faad", "folding hand fan", "Objects", "clothing", []string{"clothing"}, "15.0", false},
+			{"\U0001f45b", "purse", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f45c", "handbag", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f45d", "clutch bag", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f6cd\ufe0f", "shopping bags", "Objects", "clothing", []string{"clothing"}, "0.7", false},
+			{"\U0001f392", "backpack", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001fa74", "thong sandal", "Objects", "clothing", []string{"clothing"}, "13.0", false},
+			{"\U0001f45e", "man’s shoe", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f45f", "running shoe", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f97e", "hiking boot", "Objects", "clothing", []string{"clothing"}, "11.0", false},
+			{"\U0001f97f", "flat shoe", "Objects", "clothing", []string{"clothing"}, "11.0", false},
+			{"\U0001f460", "high-heeled shoe", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f461", "woman’s sandal", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001fa70", "ballet shoes", "Objects", "clothing", []string{"clothing"}, "12.0", false},
+			{"\U0001f462", "woman’s boot", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001faae", "hair pick", "Objects", "clothing", []string{"clothing"}, "15.0", false},
+			{"\U0001f451", "crown", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f452", "woman’s hat", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f3a9", "top hat", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f393", "graduation cap", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f9e2", "billed cap", "Objects", "clothing", []string{"clothing"}, "5.0", false},
+			{"\U0001fa96", "military helmet", "Objects", "clothing", []string{"clothing"}, "13.0", false},
+			{"\u26d1\ufe0f", "rescue worker’s helmet", "Objects", "clothing", []string{"clothing"}, "0.7", false},
+			{"\U0001f4ff", "prayer beads", "Objects", "clothing", []string{"clothing"}, "1.0", false},
+			{"\U0001f484", "lipstick", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f48d", "ring", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f48e", "gem stone", "Objects", "clothing", []string{"clothing"}, "0.6", false},
+			{"\U0001f507", "muted speaker", "Objects", "sound", []string{"sound"}, "1.0", false},
+			{"\U0001f508", "speaker low volume", "Objects", "sound", []string{"sound"}, "0.7", false},
+			{"\U0001f509", "speaker medium volume", "Objects", "sound", []string{"sound"}, "1.0", false},
+			{"\U0001f50a", "speaker high volume", "Objects", "sound", []string{"sound"}, "0.6", false},
+			{"\U0001f4e2", "loudspeaker", "Objects", "sound", []string{"sound"}, "0.6", false},
+			{"\U0001f4e3", "megaphone", "Objects", "sound", []string{"sound"}, "0.6", false},
+			{"\U0001f4ef", "postal horn", "Objects", "sound", []string{"sound"}, "1.0", false},
+			{"\U0001f514", "bell", "Objects", "sound", []string{"sound"}, "0.6", false},
+			{"\U0001f515", "bell with slash", "Objects", "sound", []string{"sound"}, "1.0", false},
+			{"\U0001f3bc", "musical score", "Objects", "music", []string{"music"}, "0.6", false},
+			{"\U0001f3b5", "musical note", "Objects", "music", []string{"music"}, "0.6", false},
+			{"\U0001f3b6", "musical notes", "Objects", "music", []string{"music"}, "0.6", false},
+			{"\U0001f399\ufe0f", "studio microphone", "Objects", "music", []string{"music"}, "0.7", false},
+			{"\U0001f39a\ufe0f", "level slider", "Objects", "music", []string{"music"}, "0.7", false},
+			{"\U0001f39b\ufe0f", "control knobs", "Objects", "music", []string{"music"}, "0.7", false},
+			{"\U0001f3a4", "microphone", "Objects", "music", []string{"music"}, "0.6", false},
+			{"\U0001f3a7", "headphone", "Objects", "music", []string{"music"}, "0.6", false},
+			{"\U0001f4fb", "radio", "Objects", "music", []string{"music"}, "0.6", false},
+			{"\U0001f3b7", "saxophone", "Objects", "musical-instrument", []string{"instrument", "musical"}, "0.6", false},
+			{"\U0001fa97", "accordion", "Objects", "musical-instrument", []string{"instrument", "musical"}, "13.0", false},
+			{"\U0001f3b8", "guitar", "Objects", "musical-instrument", []string{"instrument", "musical"}, "0.6", false},
+			{"\U0001f3b9", "musical keyboard", "Objects", "musical-instrument", []string{"instrument", "musical"}, "0.6", false},
+			{"\U0001f3ba", "trumpet", "Objects", "musical-instrument", []string{"instrument", "musical"}, "0.6", false},
+			{"\U0001f3bb", "violin", "Objects", "musical-instrument", []string{"instrument", "musical"}, "0.6", false},
+			{"\U0001fa95", "banjo", "Objects", "musical-instrument", []string{"instrument", "musical"}, "12.0", false},
+			{"\U0001f941", "drum", "Objects", "musical-instrument", []string{"instrument", "musical"}, "3.0", false},
+			{"\U0001fa98", "long drum", "Objects", "musical-instrument", []string{"instrument", "musical"}, "13.0", false},
+			{"\U0001fa87", "maracas", "Objects", "musical-instrument", []string{"instrument", "musical"}, "15.0", false},
+			{"\U0001fa88", "flute", "Objects", "musical-instrument", []string{"instrument", "musical"}, "15.0", false},
+			{"\U0001f4f1", "mobile phone", "Objects", "phone", []string{"phone"}, "0.6", false},
+			{"\U0001f4f2", "mobile phone with arrow", "Objects", "phone", []string{"phone"}, "0.6", false},
+			{"\u260e\ufe0f", "telephone", "Objects", "phone", []string{"phone"}, "0.6", false},
+			{"\U0001f4de", "telephone receiver", "Objects", "phone", []string{"phone"}, "0.6", false},
+			{"\U0001f4df", "pager", "Objects", "phone", []string{"phone"}, "0.6", false},
+			{"\U0001f4e0", "fax machine", "Objects", "phone", []string{"phone"}, "0.6", false},
+			{"\U0001f50b", "battery", "Objects", "computer", []string{"computer"}, "0.6", false},
+			{"\U0001faab", "low battery", "Objects", "computer", []string{"computer"}, "14.0", false},
+			{"\U0001f50c", "electric plug", "Objects", "computer", []string{"computer"}, "0.6", false},
+			{"\U0001f4bb", "laptop", "Objects", "computer", []string{"computer"}, "0.6", false},
+			{"\U0001f5a5\ufe0f", "desktop computer", "Objects", "computer", []string{"computer"}, "0.7", false},
+			{"\U0001f5a8\ufe0f", "printer", "Objects", "computer", []string{"computer"}, "0.7", false},
+			{"\u2328\ufe0f", "keyboard", "Objects", "computer", []string{"computer"}, "1.0", false},
+			{"\U0001f5b1\ufe0f", "computer mouse", "Objects", "computer", []string{"computer"}, "0.7", false},
+			{"\U0001f5b2\ufe0f", "trackball", "Objects", "computer", []string{"computer"}, "0.7", false},
+			{"\U0001f4bd", "computer disk", "Objects", "computer", []string{"computer"}, "0.6", false},
+			{"\U0001f4be", "floppy disk", "Objects", "computer", []string{"computer"}, "0.6", false},
+			{"\U0001f4bf", "optical disk", "Objects", "computer", []string{"computer"}, "0.6", false},
+			{"\U0001f4c0", "dvd", "Objects", "computer", []string{"computer"}, "0.6", false},
+			{"\U0001f9ee", "abacus", "Objects", "computer", []string{"computer"}, "11.0", false},
+			{"\U0001f3a5", "movie camera", "Objects", "light & video", []string{"light & video"}, "0.6", false},
+			{"\U0001f39e\ufe0f", "film frames", "Objects", "light & video", []string{"light & video"}, "0.7", false},
+			{"\U0001f4fd\ufe0f", "film projector", "Objects", "light & video", []string{"light & video"}, "0.7", false},
+			{"\U0001f3ac", "clapper board", "Objects", "light & video", []string{"light & video"}, "0.6", false},
+			{"\U0001f4fa", "television", "Objects", "light & video", []string{"light & video"}, "0.6", false},
+			{"\U0001f4f7", "camera", "Objects", "light & video", []string{"light & video"}, "0.6", false},
+			{"\U0001f4f8", "camera with flash", "Objects", "light & video", []string{"light & video"}, "1.0", false},
+			{"\U0001f4f9", "video camera", "Objects", "light & video", []string{"light & video"}, "0.6", false},
+			{"\U0001f4fc", "videocassette", "Objects", "light & video", []string{"light & video"}, "0.6", false},
+			{"\U0001f50d", "magnifying glass tilted left", "Objects", "light & video", []string{"light & video"}, "0.6", false},
+			{"\U0001f50e", "magnifying glass tilted right", "Objects", "light & video", []string{"light & video"}, "0.6", false},
+			{"\U0001f56f\ufe0f", "candle", "Objects", "light & video", []string{"light & video"}, "0.7", false},
+			{"\U0001f4a1", "light bulb", "Objects", "light & video", []string{"light & video"}, "0.6", false},
+			{"\U0001f526", "flashlight", "Objects", "light & video", []string{"light & video"}, "0.6", false},
+			{"\U0001f3ee", "red paper lantern", "Objects", "light & video", []string{"light & video"}, "0.6", false},
+			{"\U0001fa94", "diya lamp", "Objects", "light & video", []string{"light & video"}, "12.0", false},
+			{"\U0001f4d4", "notebook with decorative cover", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f4d5", "closed book", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f4d6", "open book", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f4d7", "green book", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f4d8", "blue book", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f4d9", "orange book", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f4da", "books", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f4d3", "notebook", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f4d2", "ledger", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f4c3", "page with curl", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f4dc", "scroll", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f4c4", "page facing up", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f4f0", "newspaper", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f5de\ufe0f", "rolled-up newspaper", "Objects", "book-paper", []string{"book", "paper"}, "0.7", false},
+			{"\U0001f4d1", "bookmark tabs", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f516", "bookmark", "Objects", "book-paper", []string{"book", "paper"}, "0.6", false},
+			{"\U0001f3f7\ufe0f", "label", "Objects", "book-paper", []string{"book", "paper"}, "0.7", false},
+			{"\U0001f4b0", "money bag", "Objects", "money", []string{"money"}, "0.6", false},
+			{"\U0001fa99", "coin", "Objects", "money", []string{"money"}, "13.0", false},
+			{"\U0001f4b4", "yen banknote", "Objects", "money", []string{"money"}, "0.6", false},
+			{"\U0001f4b5", "dollar banknote", "Objects", "money", []string{"money"}, "0.6", false},
+			{"\U0001f4b6", "euro banknote", "Objects", "money", []string{"money"}, "1.0", false},
+			{"\U0001f4b7", "pound banknote", "Objects", "money", []string{"money"}, "1.0", false},
+			{"\U0001f4b8", "money with wings", "Objects", "money", []string{"money"}, "0.6", false},
+			{"\U0001f4b3", "credit card", "Objects", "money", []string{"money"}, "0.6", false},
+			{"\U0001f9fe", "receipt", "Objects", "money", []string{"money"}, "11.0", false},
+			{"\U0001f4b9", "chart increasing with yen", "Objects", "money", []string{"money"}, "0.6", false},
+			{"\u2709\ufe0f", "envelope", "Objects", "mail", []string{"mail"}, "0.6", false},
+			{"\U0001f4e7", "e-mail", "Objects", "mail", []string{"mail"}, "0.6", false},
+			{"\U0001f4e8", "incoming envelope", "Objects", "mail", []string{"mail"}, "0.6", false},
+			{"\U0001f4e9", "envelope with arrow", "Objects", "mail", []string{"mail"}, "0.6", false},
+			{"\U0001f4e4", "outbox tray", "Objects", "mail", []string{"mail"}, "0.6", false},
+			{"\U0001f4e5", "inbox tray", "Objects", "mail", []string{"mail"}, "0.6", false},
+			{"\U0001f4e6", "package", "Objects", "mail", []string{"mail"}, "0.6", false},
+			{"\U0001f4eb", "closed mailbox with raised flag", "Objects", "mail", []string{"mail"}, "0.6", false},
+			{"\U0001f4ea", "closed mailbox with lowered flag", "Objects", "mail", []string{"mail"}, "0.6", false},
+			{"\U0001f4ec", "open mailbox with raised flag", "Objects", "mail", []string{"mail"}, "0.7", false},
+			{"\U0001f4ed", "open mailbox with lowered flag", "Objects", "mail", []string{"mail"}, "0.7", false},
+			{"\U0001f4ee", "postbox", "Objects", "mail", []string{"mail"}, "0.6", false},
+			{"\U0001f5f3\ufe0f", "ballot box with ballot", "Objects", "mail", []string{"mail"}, "0.7", false},
+			{"\u270f\ufe0f", "pencil", "Objects", "writing", []string{"writing"}, "0.6", false},
+			{"\u2712\ufe0f", "black nib", "Objects", "writing", []string{"writing"}, "0.6", false},
+			{"\U0001f58b\ufe0f", "fountain pen", "Objects", "writing", []string{"writing"}, "0.7", false},
+			{"\U0001f58a\ufe0f", "pen", "Objects", "writing", []string{"writing"}, "0.7", false},
+			{"\U0001f58c\ufe0f", "paintbrush", "Objects", "writing", []string{"writing"}, "0.7", false},
+			{"\U0001f58d\ufe0f", "crayon", "Objects", "writing", []string{"writing"}, "0.7", false},
+			{"\U0001f4dd", "memo", "Objects", "writing", []string{"writing"}, "0.6", false},
+			{"\U0001f4bc", "briefcase", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f4c1", "file folder", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f4c2", "open file folder", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f5c2\ufe0f", "card index dividers", "Objects", "office", []string{"office"}, "0.7", false},
+			{"\U0001f4c5", "calendar", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f4c6", "tear-off calendar", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f5d2\ufe0f", "spiral notepad", "Objects", "office", []string{"office"}, "0.7", false},
+			{"\U0001f5d3\ufe0f", "spiral calendar", "Objects", "office", []string{"office"}, "0.7", false},
+			{"\U0001f4c7", "card index", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f4c8", "chart increasing", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f4c9", "chart decreasing", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f4ca", "bar chart", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f4cb", "clipboard", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f4cc", "pushpin", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f4cd", "round pushpin", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f4ce", "paperclip", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f587\ufe0f", "linked paperclips", "Objects", "office", []string{"office"}, "0.7", false},
+			{"\U0001f4cf", "straight ruler", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f4d0", "triangular ruler", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\u2702\ufe0f", "scissors", "Objects", "office", []string{"office"}, "0.6", false},
+			{"\U0001f5c3\ufe0f", "card file box", "Objects", "office", []string{"office"}, "0.7", false},
+			{"\U0001f5c4\ufe0f", "file cabinet", "Objects", "office", []string{"office"}, "0.7", false},
+			{"\U0001f5d1\ufe0f", "wastebasket", "Objects", "office", []string{"office"}, "0.7", false},
+			{"\U0001f512", "locked", "Objects", "lock", []string{"lock"}, "0.6", false},
+			{"\U0001f513", "unlocked", "Objects", "lock", []string{"lock"}, "0.6", false},
+			{"\U0001f50f", "locked with pen", "Objects", "lock", []string{"lock"}, "0.6", false},
+			{"\U0001f510", "locked with key", "Objects", "lock", []string{"lock"}, "0.6", false},
+			{"\U0001f511", "key", "Objects", "lock", []string{"lock"}, "0.6", false},
+			{"\U0001f5dd\ufe0f", "old key", "Objects", "lock", []string{"lock"}, "0.7", false},
+			{"\U0001f528", "hammer", "Objects", "tool", []string{"tool"}, "0.6", false},
+			{"\U0001fa93", "axe", "Objects", "tool", []string{"tool"}, "12.0", false},
+			{"\u26cf\ufe0f", "pick", "Objects", "tool", []string{"tool"}, "0.7", false},
+			{"\u2692\ufe0f", "hammer and pick", "Objects", "tool", []string{"tool"}, "1.0", false},
+			{"\U0001f6e0\ufe0f", "hammer and wrench", "Objects", "tool", []string{"tool"}, "0.7", false},
+			{"\U0001f5e1\ufe0f", "dagger", "Objects", "tool", []string{"tool"}, "0.7", false},
+			{"\u2694\ufe0f", "crossed swords", "Objects", "tool", []string{"tool"}, "1.0", false},
+			{"\U0001f4a3", "bomb", "Objects", "tool", []string{"tool"}, "0.6", false},
+			{"\U0001fa83", "boomerang", "Objects", "tool", []string{"tool"}, "13.0", false},
+			{"\U0001f3f9", "bow and arrow", "Objects", "tool", []string{"tool"}, "1.0", false},
+			{"\U0001f6e1\ufe0f", "shield", "Objects", "tool", []string{"tool"}, "0.7", false},
+			{"\U0001fa9a", "carpentry saw", "Objects", "tool", []string{"tool"}, "13.0", false},
+			{"\U0001f527", "wrench", "Objects", "tool", []string{"tool"}, "0.6", false},
+			{"\U0001fa9b", "screwdriver", "Objects", "tool", []string{"tool"}, "13.0", false},
+			{"\U0001f529", "nut and bolt", "Objects", "tool", []string{"tool"}, "0.6", false},
+			{"\u2699\ufe0f", "gear", "Objects", "tool", []string{"tool"}, "1.0", false},
+			{"\U0001f5dc\ufe0f", "clamp", "Objects", "tool", []string{"tool"}, "0.7", false},
+			{"\u2696\ufe0f", "balance scale", "Objects", "tool", []string{"tool"}, "1.0", false},
+			{"\U0001f9af", "white cane", "Objects", "tool", []string{"tool"}, "12.0", false},
+			{"\U0001f517", "link", "Objects", "tool", []string{"tool"}, "0.6", false},
+			{"\u26d3\ufe0f\u200d\U0001f4a5", "broken chain", "Objects", "tool", []string{"tool"}, "15.1", false},
+			{"\u26d3\ufe0f", "chains", "Objects", "tool", []string{"tool"}, "0.7", false},
+			{"\U0001fa9d", "hook", "Objects", "tool", []string{"tool"}, "13.0", false},
+			{"\U0001f9f0", "toolbox", "Objects", "tool", []string{"tool"}, "11.0", false},
+			{"\U0001f9f2", "magnet", "Objects", "tool", []string{"tool"}, "11.0", false},
+			{"\U0001fa9c", "ladder", "Objects", "tool", []string{"tool"}, "13.0", false},
+			{"\u2697\ufe0f", "alembic", "Objects", "science", []string{"science"}, "1.0", false},
+			{"\U0001f9ea", "test tube", "Objects", "science", []string{"science"}, "11.0", false},
+			{"\U0001f9eb", "petri dish", "Objects", "science", []string{"science"}, "11.0", false},
+			{"\U0001f9ec", "dna", "Objects", "science", []string{"science"}, "11.0", false},
+			{"\U0001f52c", "microscope", "Objects", "science", []string{"science"}, "1.0", false},
+			{"\U0001f52d", "telescope", "Objects", "science", []string{"science"}, "1.0", false},
+			{"\U0001f4e1", "satellite antenna", "Objects", "science", []string{"science"}, "0.6", false},
+			{"\U0001f489", "syringe", "Objects", "medical", []string{"medical"}, "0.6", false},
+			{"\U0001fa78", "drop of blood", "Objects", "medical", []string{"medical"}, "12.0", false},
+			{"\U0001f48a", "pill", "Objects", "medical", []string{"medical"}, "0.6", false},
+			{"\U0001fa79", "adhesive bandage", "Objects", "medical", []string{"medical"}, "12.0", false},
+			{"\U0001fa7c", "crutch", "Objects", "medical", []string{"medical"}, "14.0", false},
+			{"\U0001fa7a", "stethoscope", "Objects", "medical", []string{"medical"}, "12.0", false},
+			{"\U0001fa7b", "x-ray", "Objects", "medical", []string{"medical"}, "14.0", false},
+			{"\U0001f6aa", "door", "Objects", "household", []string{"household"}, "0.6", false},
+			{"\U0001f6d7", "elevator", "Objects", "household", []string{"household"}, "13.0", false},
+			{"\U0001fa9e", "mirror", "Objects", "household", []string{"household"}, "13.0", false},
+			{"\U0001fa9f", "window", "Objects", "household", []string{"household"}, "13.0", false},
+			{"\U0001f6cf\ufe0f", "bed", "Objects", "household", []string{"household"}, "0.7", false},
+			{"\U0001f6cb\ufe0f", "couch and lamp", "Objects", "household", []string{"household"}, "0.7", false},
+			{"\U0001fa91", "chair", "Objects", "household", []string{"household"}, "12.0", false},
+			{"\U0001f6bd", "toilet", "Objects", "household", []string{"household"}, "0.6", false},
+			{"\U0001faa0", "plunger", "Objects", "household", []string{"household"}, "13.0", false},
+			{"\U0001f6bf", "shower", "Objects", "household", []string{"household"}, "1.0", false},
+			{"\U0001f6c1", "bathtub", "Objects", "household", []string{"household"}, "1.0", false},
+			{"\U0001faa4", "mouse trap", "Objects", "household", []string{"household"}, "13.0", false},
+			{"\U0001fa92", "razor", "Objects", "household", []string{"household"}, "12.0", false},
+			{"\U0001f9f4", "lotion bottle", "Objects", "household", []string{"household"}, "11.0", false},
+			{"\U0001f9f7", "safety pin", "Objects", "household", []string{"household"}, "11.0", false},
+			{"\U0001f9f9", "broom", "Objects", "household", []string{"household"}, "11.0", false},
+			{"\U0001f9fa", "basket", "Objects", "household", []string{"household"}, "11.0", false},
+			{"\U0001f9fb", "roll of paper", "Objects", "household", []string{"household"}, "11.0", false},
+			{"\U0001faa3", "bucket", "Objects", "household", []string{"household"}, "13.0", false},
+			{"\U0001f9fc", "soap", "Objects", "household", []string{"household"}, "11.0", false},
+			{"\U0001fae7", "bubbles", "Objects", "household", []string{"household"}, "14.0", false},
+			{"\U0001faa5", "toothbrush", "Objects", "household", []string{"household"}, "13.0", false},
+			{"\U0001f9fd", "sponge", "Objects", "household", []string{"household"}, "11.0", false},
+			{"\U0001f9ef", "fire extinguisher", "Objects", "household", []string{"household"}, "11.0", false},
+			{"\U0001f6d2", "shopping cart", "Objects", "household", []string{"household"}, "3.0", false},
+			{"\U0001f6ac", "cigarette", "Objects", "other-object", []string{"object", "other"}, "0.6", false},
+			{"\u26b0\ufe0f", "coffin", "Objects", "other-object", []string{"object", "other"}, "1.0", false},
+			{"\U0001faa6", "headstone", "Objects", "other-object", []string{"object", "other"}, "13.0", false},
+			{"\u26b1\ufe0f", "funeral urn", "Objects", "other-object", []string{"object", "other"}, "1.0", false},
+			{"\U0001f9ff", "nazar amulet", "Objects", "other-object", []string{"object", "other"}, "11.0", false},
+			{"\U0001faac", "hamsa", "Objects", "other-object", []string{"object", "other"}, "14.0", false},
+			{"\U0001f5ff", "moai", "Objects", "other-object", []string{"object", "other"}, "0.6", false},
+			{"\U0001faa7", "placard", "Objects", "other-object", []string{"object", "other"}, "13.0", false},
+			{"\U0001faaa", "identification card", "Objects", "other-object", []string{"object", "other"}, "14.0", false},
+			{"\U0001f3e7", "ATM sign", "Symbols", "transport-sign", []string{"sign", "transport"}, "0.6", false},
+			{"\U0001f6ae", "litter in bin sign", "Symbols", "transport-sign", []string{"sign", "transport"}, "1.0", false},
+			{"\U0001f6b0", "potable water", "Symbols", "transport-sign", []string{"sign", "transport"}, "1.0", false},
+			{"\u267f", "wheelchair symbol", "Symbols", "transport-sign", []string{"sign", "transport"}, "0.6", false},
+			{"\U0001f6b9", "men’s room", "Symbols", "transport-sign", []string{"sign", "transport"}, "0.6", false},
+			{"\U0001f6ba", "women’s room", "Symbols", "transport-sign", []string{"sign", "transport"}, "0.6", false},
+			{"\U0001f6bb", "restroom", "Symbols", "transport-sign", []string{"sign", "transport"}, "0.6", false},
+			{"\U0001f6bc", "baby symbol", "Symbols", "transport-sign", []string{"sign", "transport"}, "0.6", false},
+			{"\U0001f6be", "water closet", "Symbols", "transport-sign", []string{"sign", "transport"}, "0.6", false},
+			{"\U0001f6c2", "passport control", "Symbols", "transport-sign", []string{"sign", "transport"}, "1.0", false},
+			{"\U0001f6c3", "customs", "Symbols", "transport-sign", []string{"sign", "transport"}, "1.0", false},
+			{"\U0001f6c4", "baggage claim", "Symbols", "transport-sign", []string{"sign", "transport"}, "1.0", false},
+			{"\U0001f6c5", "left luggage", "Symbols", "transport-sign", []string{"sign", "transport"}, "1.0", false},
+			{"\u26a0\ufe0f", "warning", "Symbols", "warning", []string{"warning"}, "0.6", false},
+			{"\U0001f6b8", "children crossing", "Symbols", "warning", []string{"warning"}, "1.0", false},
+			{"\u26d4", "no entry", "Symbols", "warning", []string{"warning"}, "0.6", false},
+			{"\U0001f6ab", "prohibited", "Symbols", "warning", []string{"warning"}, "0.6", false},
+			{"\U0001f6b3", "no bicycles", "Symbols", "warning", []string{"warning"}, "1.0", false},
+			{"\U0001f6ad", "no smoking", "Symbols", "warning", []string{"warning"}, "0.6", false},
+			{"\U0001f6af", "no littering", "Symbols", "warning", []string{"warning"}, "1.0", false},
+			{"\U0001f6b1", "non-potable water", "Symbols", "warning", []string{"warning"}, "1.0", false},
+			{"\U0001f6b7", "no pedestrians", "Symbols", "warning", []string{"warning"}, "1.0", false},
+			{"\U0001f4f5", "no mobile phones", "Symbols", "warning", []string{"warning"}, "1.0", false},
+			{"\U0001f51e", "no one under eighteen", "Symbols", "warning", []string{"warning"}, "0.6", false},
+			{"\u2622\ufe0f", "radioactive", "Symbols", "warning", []string{"warning"}, "1.0", false},
+			{"\u2623\ufe0f", "biohazard", "Symbols", "warning", []string{"warning"}, "1.0", false},
+			{"\u2b06\ufe0f", "up arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\u2197\ufe0f", "up-right arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\u27a1\ufe0f", "right arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\u2198\ufe0f", "down-right arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\u2b07\ufe0f", "down arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\u2199\ufe0f", "down-left arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\u2b05\ufe0f", "left arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\u2196\ufe0f", "up-left arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\u2195\ufe0f", "up-down arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\u2194\ufe0f", "left-right arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\u21a9\ufe0f", "right arrow curving left", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\u21aa\ufe0f", "left arrow curving right", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\u2934\ufe0f", "right arrow curving up", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\u2935\ufe0f", "right arrow curving down", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\U0001f503", "clockwise vertical arrows", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\U0001f504", "counterclockwise arrows button", "Symbols", "arrow", []string{"arrow"}, "1.0", false},
+			{"\U0001f519", "BACK arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\U0001f51a", "END arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\U0001f51b", "ON! arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\U0001f51c", "SOON arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\U0001f51d", "TOP arrow", "Symbols", "arrow", []string{"arrow"}, "0.6", false},
+			{"\U0001f6d0", "place of worship", "Symbols", "religion", []string{"religion"}, "1.0", false},
+			{"\u269b\ufe0f", "atom symbol", "Symbols", "religion", []string{"religion"}, "1.0", false},
+			{"\U0001f549\ufe0f", "om", "Symbols", "religion", []string{"religion"}, "0.7", false},
+			{"\u2721\ufe0f", "star of David", "Symbols", "religion", []string{"religion"}, "0.7", false},
+			{"\u2638\ufe0f", "wheel of dharma", "Symbols", "religion", []string{"religion"}, "0.7", false},
+			{"\u262f\ufe0f", "yin yang", "Symbols", "religion", []string{"religion"}, "0.7", false},
+			{"\u271d\ufe0f", "latin cross", "Symbols", "religion", []string{"religion"}, "0.7", false},
+			{"\u2626\ufe0f", "orthodox cross", "Symbols", "religion", []string{"religion"}, "1.0", false},
+			{"\u262a\ufe0f", "star and crescent", "Symbols", "religion", []string{"religion"}, "0.7", false},
+			{"\u262e\ufe0f", "peace symbol", "Symbols", "religion", []string{"religion"}, "1.0", false},
+			{"\U0001f54e", "menorah", "Symbols", "religion", []string{"religion"}, "1.0", false},
+			{"\U0001f52f", "dotted six-pointed star", "Symbols", "religion", []string{"religion"}, "0.6", false},
+			{"\U0001faaf", "khanda", "Symbols", "religion", []string{"religion"}, "15.0", false},
+			{"\u2648", "Aries", "Symbols", "zodiac", []string{"zodiac"}, "0.6", false},
+			{"\u2649", "Taurus", "Symbols", "zodiac", []string{"zodiac"}, "0.6", false},
+			{"\u264a", "Gemini", "Symbols", "zodiac", []string{"zodiac"}, "0.6", false},
+			{"\u264b", "Cancer", "Symbols", "zodiac", []string{"zodiac"}, "0.6", false},
+			{"\u264c", "Leo", "Symbols", "zodiac", []string{"zodiac"}, "0.6", false},
+			{"\u264d", "Virgo", "Symbols", "zodiac", []string{"zodiac"}, "0.6", false},
+			{"\u264e", "Libra", "Symbols", "zodiac", []string{"zodiac"}, "0.6", false},
+			{"\u264f", "Scorpio", "Symbols", "zodiac", []string{"zodiac"}, "0.6", false},
+			{"\u2650", "Sagittarius", "Symbols", "zodiac", []string{"zodiac"}, "0.6", false},
+			{"\u2651", "Capricorn", "Symbols", "zodiac", []string{"zodiac"}, "0.6", false},
+			{"\u2652", "Aquarius", "Symbols", "zodiac", []string{"zodiac"}, "0.6", false},
+			{"\u2653", "Pisces", "Symbols", "zodiac", []string{"zodiac"}, "0.6", false},
+			{"\u26ce", "Ophiuchus", "Symbols", "zodiac", []string{"zodiac"}, "0.6", false},
+			{"\U0001f500", "shuffle tracks button", "Symbols", "av-symbol", []string{"av", "symbol"}, "1.0", false},
+			{"\U0001f501", "repeat button", "Symbols", "av-symbol", []string{"av", "symbol"}, "1.0", false},
+			{"\U0001f502", "repeat single button", "Symbols", "av-symbol", []string{"av", "symbol"}, "1.0", false},
+			{"\u25b6\ufe0f", "play button", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.6", false},
+			{"\u23e9", "fast-forward button", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.6", false},
+			{"\u23ed\ufe0f", "next track button", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.7", false},
+			{"\u23ef\ufe0f", "play or pause button", "Symbols", "av-symbol", []string{"av", "symbol"}, "1.0", false},
+			{"\u25c0\ufe0f", "reverse button", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.6", false},
+			{"\u23ea", "fast reverse button", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.6", false},
+			{"\u23ee\ufe0f", "last track button", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.7", false},
+			{"\U0001f53c", "upwards button", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.6", false},
+			{"\u23eb", "fast up button", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.6", false},
+			{"\U0001f53d", "downwards button", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.6", false},
+			{"\u23ec", "fast down button", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.6", false},
+			{"\u23f8\ufe0f", "pause button", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.7", false},
+			{"\u23f9\ufe0f", "stop button", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.7", false},
+			{"\u23fa\ufe0f", "record button", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.7", false},
+			{"\u23cf\ufe0f", "eject button", "Symbols", "av-symbol", []string{"av", "symbol"}, "1.0", false},
+			{"\U0001f3a6", "cinema", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.6", false},
+			{"\U0001f505", "dim button", "Symbols", "av-symbol", []string{"av", "symbol"}, "1.0", false},
+			{"\U0001f506", "bright button", "Symbols", "av-symbol", []string{"av", "symbol"}, "1.0", false},
+			{"\U0001f4f6", "antenna bars", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.6", false},
+			{"\U0001f6dc", "wireless", "Symbols", "av-symbol", []string{"av", "symbol"}, "15.0", false},
+			{"\U0001f4f3", "vibration mode", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.6", false},
+			{"\U0001f4f4", "mobile phone off", "Symbols", "av-symbol", []string{"av", "symbol"}, "0.6", false},
+			{"\u2640\ufe0f", "female sign", "Symbols", "gender", []string{"gender"}, "4.0", false},
+			{"\u2642\ufe0f", "male sign", "Symbols", "gender", []string{"gender"}, "4.0", false},
+			{"\u26a7\ufe0f", "transgender symbol", "Symbols", "gender", []string{"gender"}, "13.0", false},
+			{"\u2716\ufe0f", "multiply", "Symbols", "math", []string{"math"}, "0.6", false},
+			{"\u2795", "plus", "Symbols", "math", []string{"math"}, "0.6", false},
+			{"\u2796", "minus", "Symbols", "math", []string{"math"}, "0.6", false},
+			{"\u2797", "divide", "Symbols", "math", []string{"math"}, "0.6", false},
+			{"\U0001f7f0", "heavy equals sign", "Symbols", "math", []string{"math"}, "14.0", false},
+			{"\u267e\ufe0f", "infinity", "Symbols", "math", []string{"math"}, "11.0", false},
+			{"\u203c\ufe0f", "double exclamation mark", "Symbols", "punctuation", []string{"punctuation"}, "0.6", false},
+			{"\u2049\ufe0f", "exclamation question mark", "Symbols", "punctuation", []string{"punctuation"}, "0.6", false},
+			{"\u2753", "red question mark", "Symbols", "punctuation", []string{"punctuation"}, "0.6", false},
+			{"\u2754", "white question mark", "Symbols", "punctuation", []string{"punctuation"}, "0.6", false},
+			{"\u2755", "white exclamation mark", "Symbols", "punctuation", []string{"punctuation"}, "0.6", false},
+			{"\u2757", "red exclamation mark", "Symbols", "punctuation", []string{"punctuation"}, "0.6", false},
+			{"\u3030\ufe0f", "wavy dash", "Symbols", "punctuation", []string{"punctuation"}, "0.6", false},
+			{"\U0001f4b1", "currency exchange", "Symbols", "currency", []string{"currency"}, "0.6", false},
+			{"\U0001f4b2", "heavy dollar sign", "Symbols", "currency", []string{"currency"}, "0.6", false},
+			{"\u2695\ufe0f", "medical symbol", "Symbols", "other-symbol", []string{"other", "symbol"}, "4.0", false},
+			{"\u267b\ufe0f", "recycling symbol", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u269c\ufe0f", "fleur-de-lis", "Symbols", "other-symbol", []string{"other", "symbol"}, "1.0", false},
+			{"\U0001f531", "trident emblem", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\U0001f4db", "name badge", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\U0001f530", "Japanese symbol for beginner", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u2b55", "hollow red circle", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u2705", "check mark button", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u2611\ufe0f", "check box with check", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u2714\ufe0f", "check mark", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u274c", "cross mark", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u274e", "cross mark button", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u27b0", "curly loop", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u27bf", "double curly loop", "Symbols", "other-symbol", []string{"other", "symbol"}, "1.0", false},
+			{"\u303d\ufe0f", "part alternation mark", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u2733\ufe0f", "eight-spoked asterisk", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u2734\ufe0f", "eight-pointed star", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u2747\ufe0f", "sparkle", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u00a9\ufe0f", "copyright", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u00ae\ufe0f", "registered", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"\u2122\ufe0f", "trade mark", "Symbols", "other-symbol", []string{"other", "symbol"}, "0.6", false},
+			{"#\ufe0f\u20e3", "keycap: #", "Symbols", "keycap", []string{"keycap"}, "0.6", false},
+			{"*\ufe0f\u20e3", "keycap: *", "Symbols", "keycap", []string{"keycap"}, "2.0", false},
+			{"0\ufe0f\u20e3", "keycap: 0", "Symbols", "keycap", []string{"keycap"}, "0.6", false},
+			{"1\ufe0f\u20e3", "keycap: 1", "Symbols", "keycap", []string{"keycap"}, "0.6", false},
+			{"2\ufe0f\u20e3", "keycap: 2", "Symbols", "keycap", []string{"keycap"}, "0.6", false},
+			{"3\ufe0f\u20e3", "keycap: 3", "Symbols", "keycap", []string{"keycap"}, "0.6", false},
+			{"4\ufe0f\u20e3", "keycap: 4", "Symbols", "keycap", []string{"keycap"}, "0.6", false},
+			{"5\ufe0f\u20e3", "keycap: 5", "Symbols", "keycap", []string{"keycap"}, "0.6", false},
+			{"6\ufe0f\u20e3", "keycap: 6", "Symbols", "keycap", []string{"keycap"}, "0.6", false},
+			{"7\ufe0f\u20e3", "keycap: 7", "Symbols", "keycap", []string{"keycap"}, "0.6", false},
+			{"8\ufe0f\u20e3", "keycap: 8", "Symbols", "keycap", []string{"keycap"}, "0.6", false},
+			{"9\ufe0f\u20e3", "keycap: 9", "Symbols", "keycap", []string{"keycap"}, "0.6", false},
+			{"\U0001f51f", "keycap: 10", "Symbols", "keycap", []string{"keycap"}, "0.6", false},
+			{"\U0001f520", "input latin uppercase", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f521", "input latin lowercase", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f522", "input numbers", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f523", "input symbols", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f524", "input latin letters", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f170\ufe0f", "A button (blood type)", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f18e", "AB button (blood type)", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f171\ufe0f", "B button (blood type)", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f191", "CL button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f192", "COOL button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f193", "FREE button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\u2139\ufe0f", "information", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f194", "ID button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\u24c2\ufe0f", "circled M", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f195", "NEW button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f196", "NG button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f17e\ufe0f", "O button (blood type)", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f197", "OK button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f17f\ufe0f", "P button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f198", "SOS button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f199", "UP! button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f19a", "VS button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f201", "Japanese “here” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f202\ufe0f", "Japanese “service charge” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f237\ufe0f", "Japanese “monthly amount” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f236", "Japanese “not free of charge” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f22f", "Japanese “reserved” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f250", "Japanese “bargain” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f239", "Japanese “discount” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f21a", "Japanese “free of charge” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f232", "Japanese “prohibited” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f251", "Japanese “acceptable” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f238", "Japanese “application” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f234", "Japanese “passing grade” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f233", "Japanese “vacancy” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\u3297\ufe0f", "Japanese “congratulations” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\u3299\ufe0f", "Japanese “secret” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f23a", "Japanese “open for business” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f235", "Japanese “no vacancy” button", "Symbols", "alphanum", []string{"alphanum"}, "0.6", false},
+			{"\U0001f534", "red circle", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\U0001f7e0", "orange circle", "Symbols", "geometric", []string{"geometric"}, "12.0", false},
+			{"\U0001f7e1", "yellow circle", "Symbols", "geometric", []string{"geometric"}, "12.0", false},
+			{"\U0001f7e2", "green circle", "Symbols", "geometric", []string{"geometric"}, "12.0", false},
+			{"\U0001f535", "blue circle", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\U0001f7e3", "purple circle", "Symbols", "geometric", []string{"geometric"}, "12.0", false},
+			{"\U0001f7e4", "brown circle", "Symbols", "geometric", []string{"geometric"}, "12.0", false},
+			{"\u26ab", "black circle", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\u26aa", "white circle", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\U0001f7e5", "red square", "Symbols", "geometric", []string{"geometric"}, "12.0", false},
+			{"\U0001f7e7", "orange square", "Symbols", "geometric", []string{"geometric"}, "12.0", false},
+			{"\U0001f7e8", "yellow square", "Symbols", "geometric", []string{"geometric"}, "12.0", false},
+			{"\U0001f7e9", "green square", "Symbols", "geometric", []string{"geometric"}, "12.0", false},
+			{"\U0001f7e6", "blue square", "Symbols", "geometric", []string{"geometric"}, "12.0", false},
+			{"\U0001f7ea", "purple square", "Symbols", "geometric", []string{"geometric"}, "12.0", false},
+			{"\U0001f7eb", "brown square", "Symbols", "geometric", []string{"geometric"}, "12.0", false},
+			{"\u2b1b", "black large square", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\u2b1c", "white large square", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\u25fc\ufe0f", "black medium square", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\u25fb\ufe0f", "white medium square", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\u25fe", "black medium-small square", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\u25fd", "white medium-small square", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\u25aa\ufe0f", "black small square", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\u25ab\ufe0f", "white small square", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\U0001f536", "large orange diamond", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\U0001f537", "large blue diamond", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\U0001f538", "small orange diamond", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\U0001f539", "small blue diamond", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\U0001f53a", "red triangle pointed up", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\U0001f53b", "red triangle pointed down", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\U0001f4a0", "diamond with a dot", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\U0001f518", "radio button", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\U0001f533", "white square button", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\U0001f532", "black square button", "Symbols", "geometric", []string{"geometric"}, "0.6", false},
+			{"\U0001f3c1", "chequered flag", "Flags", "flag", []string{"flag"}, "0.6", false},
+			{"\U0001f6a9", "triangular flag", "Flags", "flag", []string{"flag"}, "0.6", false},
+			{"\U0001f38c", "crossed flags", "Flags", "flag", []string{"flag"}, "0.6", false},
+			{"\U0001f3f4", "black flag", "Flags", "flag", []string{"flag"}, "1.0", false},
+			{"\U0001f3f3\ufe0f", "white flag", "Flags", "flag", []string{"flag"}, "0.7", false},
+			{"\U0001f3f3\ufe0f\u200d\U0001f308", "rainbow flag", "Flags", "flag", []string{"flag"}, "4.0", false},
+			{"\U0001f3f3\ufe0f\u200d\u26a7\ufe0f", "transgender flag", "Flags", "flag", []string{"flag"}, "13.0", false},
+			{"\U0001f3f4\u200d\u2620\ufe0f", "pirate flag", "Flags", "flag", []string{"flag"}, "11.0", false},
+			{"\U0001f1e6\U0001f1e8", "flag: Ascension Island", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1e9", "flag: Andorra", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1ea", "flag: United Arab Emirates", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1eb", "flag: Afghanistan", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1ec", "flag: Antigua & Barbuda", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1ee", "flag: Anguilla", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1f1", "flag: Albania", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1f2", "flag: Armenia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1f4", "flag: Angola", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1f6", "flag: Antarctica", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1f7", "flag: Argentina", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1f8", "flag: American Samoa", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1f9", "flag: Austria", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1fa", "flag: Australia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1fc", "flag: Aruba", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1fd", "flag: Åland Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e6\U0001f1ff", "flag: Azerbaijan", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1e6", "flag: Bosnia & Herzegovina", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1e7", "flag: Barbados", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1e9", "flag: Bangladesh", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1ea", "flag: Belgium", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1eb", "flag: Burkina Faso", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1ec", "flag: Bulgaria", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1ed", "flag: Bahrain", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1ee", "flag: Burundi", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1ef", "flag: Benin", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1f1", "flag: St. Barthélemy", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1f2", "flag: Bermuda", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1f3", "flag: Brunei", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1f4", "flag: Bolivia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1f6", "flag: Caribbean Netherlands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1f7", "flag: Brazil", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1f8", "flag: Bahamas", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1f9", "flag: Bhutan", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1fb", "flag: Bouvet Island", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1fc", "flag: Botswana", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1fe", "flag: Belarus", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e7\U0001f1ff", "flag: Belize", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1e6", "flag: Canada", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1e8", "flag: Cocos (Keeling) Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1e9", "flag: Congo - Kinshasa", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1eb", "flag: Central African Republic", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1ec", "flag: Congo - Brazzaville", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1ed", "flag: Switzerland", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1ee", "flag: Côte d’Ivoire", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1f0", "flag: Cook Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1f1", "flag: Chile", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1f2", "flag: Cameroon", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1f3", "flag: China", "Flags", "country-flag", []string{"country", "flag"}, "0.6", false},
+			{"\U0001f1e8\U0001f1f4", "flag: Colombia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1f5", "flag: Clipperton Island", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1f7", "flag: Costa Rica", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1fa", "flag: Cuba", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1fb", "flag: Cape Verde", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1fc", "flag: Curaçao", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1fd", "flag: Christmas Island", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1fe", "flag: Cyprus", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e8\U0001f1ff", "flag: Czechia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e9\U0001f1ea", "flag: Germany", "Flags", "country-flag", []string{"country", "flag"}, "0.6", false},
+			{"\U0001f1e9\U0001f1ec", "flag: Diego Garcia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e9\U0001f1ef", "flag: Djibouti", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e9\U0001f1f0", "flag: Denmark", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e9\U0001f1f2", "flag: Dominica", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e9\U0001f1f4", "flag: Dominican Republic", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1e9\U0001f1ff", "flag: Algeria", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ea\U0001f1e6", "flag: Ceuta & Melilla", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ea\U0001f1e8", "flag: Ecuador", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ea\U0001f1ea", "flag: Estonia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ea\U0001f1ec", "flag: Egypt", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ea\U0001f1ed", "flag: Western Sahara", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ea\U0001f1f7", "flag: Eritrea", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ea\U0001f1f8", "flag: Spain", "Flags", "country-flag", []string{"country", "flag"}, "0.6", false},
+			{"\U0001f1ea\U0001f1f9", "flag: Ethiopia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ea\U0001f1fa", "flag: European Union", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1eb\U0001f1ee", "flag: Finland", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1eb\U0001f1ef", "flag: Fiji", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1eb\U0001f1f0", "flag: Falkland Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1eb\U0001f1f2", "flag: Micronesia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1eb\U0001f1f4", "flag: Faroe Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1eb\U0001f1f7", "flag: France", "Flags", "country-flag", []string{"country", "flag"}, "0.6", false},
+			{"\U0001f1ec\U0001f1e6", "flag: Gabon", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1e7", "flag: United Kingdom", "Flags", "country-flag", []string{"country", "flag"}, "0.6", false},
+			{"\U0001f1ec\U0001f1e9", "flag: Grenada", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1ea", "flag: Georgia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1eb", "flag: French Guiana", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1ec", "flag: Guernsey", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1ed", "flag: Ghana", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1ee", "flag: Gibraltar", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1f1", "flag: Greenland", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1f2", "flag: Gambia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1f3", "flag: Guinea", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1f5", "flag: Guadeloupe", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1f6", "flag: Equatorial Guinea", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1f7", "flag: Greece", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1f8", "flag: South Georgia & South Sandwich Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1f9", "flag: Guatemala", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1fa", "flag: Guam", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1fc", "flag: Guinea-Bissau", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ec\U0001f1fe", "flag: Guyana", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ed\U0001f1f0", "flag: Hong Kong SAR China", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ed\U0001f1f2", "flag: Heard & McDonald Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ed\U0001f1f3", "flag: Honduras", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ed\U0001f1f7", "flag: Croatia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ed\U0001f1f9", "flag: Haiti", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ed\U0001f1fa", "flag: Hungary", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ee\U0001f1e8", "flag: Canary Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ee\U0001f1e9", "flag: Indonesia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ee\U0001f1ea", "flag: Ireland", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ee\U0001f1f1", "flag: Israel", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ee\U0001f1f2", "flag: Isle of Man", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ee\U0001f1f3", "flag: India", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ee\U0001f1f4", "flag: British Indian Ocean Territory", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ee\U0001f1f6", "flag: Iraq", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ee\U0001f1f7", "flag: Iran", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ee\U0001f1f8", "flag: Iceland", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ee\U0001f1f9", "flag: Italy", "Flags", "country-flag", []string{"country", "flag"}, "0.6", false},
+			{"\U0001f1ef\U0001f1ea", "flag: Jersey", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ef\U0001f1f2", "flag: Jamaica", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ef\U0001f1f4", "flag: Jordan", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ef\U0001f1f5", "flag: Japan", "Flags", "country-flag", []string{"country", "flag"}, "0.6", false},
+			{"\U0001f1f0\U0001f1ea", "flag: Kenya", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f0\U0001f1ec", "flag: Kyrgyzstan", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f0\U0001f1ed", "flag: Cambodia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f0\U0001f1ee", "flag: Kiribati", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f0\U0001f1f2", "flag: Comoros", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f0\U0001f1f3", "flag: St. Kitts & Nevis", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f0\U0001f1f5", "flag: North Korea", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f0\U0001f1f7", "flag: South Korea", "Flags", "country-flag", []string{"country", "flag"}, "0.6", false},
+			{"\U0001f1f0\U0001f1fc", "flag: Kuwait", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f0\U0001f1fe", "flag: Cayman Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f0\U0001f1ff", "flag: Kazakhstan", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f1\U0001f1e6", "flag: Laos", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f1\U0001f1e7", "flag: Lebanon", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f1\U0001f1e8", "flag: St. Lucia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f1\U0001f1ee", "flag: Liechtenstein", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f1\U0001f1f0", "flag: Sri Lanka", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f1\U0001f1f7", "flag: Liberia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f1\U0001f1f8", "flag: Lesotho", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f1\U0001f1f9", "flag: Lithuania", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f1\U0001f1fa", "flag: Luxembourg", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f1\U0001f1fb", "flag: Latvia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f1\U0001f1fe", "flag: Libya", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1e6", "flag: Morocco", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1e8", "flag: Monaco", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1e9", "flag: Moldova", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1ea", "flag: Montenegro", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1eb", "flag: St. Martin", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1ec", "flag: Madagascar", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1ed", "flag: Marshall Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1f0", "flag: North Macedonia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1f1", "flag: Mali", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1f2", "flag: Myanmar (Burma)", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1f3", "flag: Mongolia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1f4", "flag: Macao SAR China", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1f5", "flag: Northern Mariana Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1f6", "flag: Martinique", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1f7", "flag: Mauritania", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1f8", "flag: Montserrat", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1f9", "flag: Malta", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1fa", "flag: Mauritius", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1fb", "flag: Maldives", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1fc", "flag: Malawi", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1fd", "flag: Mexico", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1fe", "flag: Malaysia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f2\U0001f1ff", "flag: Mozambique", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f3\U0001f1e6", "flag: Namibia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f3\U0001f1e8", "flag: New Caledonia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f3\U0001f1ea", "flag: Niger", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f3\U0001f1eb", "flag: Norfolk Island", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f3\U0001f1ec", "flag: Nigeria", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f3\U0001f1ee", "flag: Nicaragua", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f3\U0001f1f1", "flag: Netherlands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f3\U0001f1f4", "flag: Norway", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f3\U0001f1f5", "flag: Nepal", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f3\U0001f1f7", "flag: Nauru", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f3\U0001f1fa", "flag: Niue", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f3\U0001f1ff", "flag: New Zealand", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f4\U0001f1f2", "flag: Oman", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f5\U0001f1e6", "flag: Panama", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f5\U0001f1ea", "flag: Peru", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f5\U0001f1eb", "flag: French Polynesia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f5\U0001f1ec", "flag: Papua New Guinea", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f5\U0001f1ed", "flag: Philippines", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f5\U0001f1f0", "flag: Pakistan", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f5\U0001f1f1", "flag: Poland", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f5\U0001f1f2", "flag: St. Pierre & Miquelon", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f5\U0001f1f3", "flag: Pitcairn Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f5\U0001f1f7", "flag: Puerto Rico", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f5\U0001f1f8", "flag: Palestinian Territories", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f5\U0001f1f9", "flag: Portugal", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f5\U0001f1fc", "flag: Palau", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f5\U0001f1fe", "flag: Paraguay", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f6\U0001f1e6", "flag: Qatar", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f7\U0001f1ea", "flag: Réunion", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f7\U0001f1f4", "flag: Romania", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f7\U0001f1f8", "flag: Serbia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f7\U0001f1fa", "flag: Russia", "Flags", "country-flag", []string{"country", "flag"}, "0.6", false},
+			{"\U0001f1f7\U0001f1fc", "flag: Rwanda", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1e6", "flag: Saudi Arabia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1e7", "flag: Solomon Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1e8", "flag: Seychelles", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1e9", "flag: Sudan", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1ea", "flag: Sweden", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1ec", "flag: Singapore", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1ed", "flag: St. Helena", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1ee", "flag: Slovenia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1ef", "flag: Svalbard & Jan Mayen", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1f0", "flag: Slovakia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1f1", "flag: Sierra Leone", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1f2", "flag: San Marino", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1f3", "flag: Senegal", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1f4", "flag: Somalia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1f7", "flag: Suriname", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1f8", "flag: South Sudan", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1f9", "flag: São Tomé & Príncipe", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1fb", "flag: El Salvador", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1fd", "flag: Sint Maarten", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1fe", "flag: Syria", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f8\U0001f1ff", "flag: Eswatini", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1e6", "flag: Tristan da Cunha", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1e8", "flag: Turks & Caicos Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1e9", "flag: Chad", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1eb", "flag: French Southern Territories", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1ec", "flag: Togo", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1ed", "flag: Thailand", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1ef", "flag: Tajikistan", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1f0", "flag: Tokelau", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1f1", "flag: Timor-Leste", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1f2", "flag: Turkmenistan", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1f3", "flag: Tunisia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1f4", "flag: Tonga", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1f7", "flag: Türkiye", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1f9", "flag: Trinidad & Tobago", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1fb", "flag: Tuvalu", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1fc", "flag: Taiwan", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1f9\U0001f1ff", "flag: Tanzania", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fa\U0001f1e6", "flag: Ukraine", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fa\U0001f1ec", "flag: Uganda", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fa\U0001f1f2", "flag: U.S. Outlying Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fa\U0001f1f3", "flag: United Nations", "Flags", "country-flag", []string{"country", "flag"}, "4.0", false},
+			{"\U0001f1fa\U0001f1f8", "flag: United States", "Flags", "country-flag", []string{"country", "flag"}, "0.6", false},
+			{"\U0001f1fa\U0001f1fe", "flag: Uruguay", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fa\U0001f1ff", "flag: Uzbekistan", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fb\U0001f1e6", "flag: Vatican City", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fb\U0001f1e8", "flag: St. Vincent & Grenadines", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fb\U0001f1ea", "flag: Venezuela", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fb\U0001f1ec", "flag: British Virgin Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fb\U0001f1ee", "flag: U.S. Virgin Islands", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fb\U0001f1f3", "flag: Vietnam", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fb\U0001f1fa", "flag: Vanuatu", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fc\U0001f1eb", "flag: Wallis & Futuna", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fc\U0001f1f8", "flag: Samoa", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fd\U0001f1f0", "flag: Kosovo", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fe\U0001f1ea", "flag: Yemen", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1fe\U0001f1f9", "flag: Mayotte", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ff\U0001f1e6", "flag: South Africa", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ff\U0001f1f2", "flag: Zambia", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f1ff\U0001f1fc", "flag: Zimbabwe", "Flags", "country-flag", []string{"country", "flag"}, "2.0", false},
+			{"\U0001f3f4\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "flag: England", "Flags", "subdivision-flag", []string{"flag", "subdivision"}, "5.0", false},
+			{"\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "flag: Scotland", "Flags", "subdivision-flag", []string{"flag", "subdivision"}, "5.0", false},
+			{"\U0001f3f4\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f", "flag: Wales", "Flags", "subdivision-flag", []string{"flag", "subdivision"}, "5.0", false},
+		}
+	})
+	return emojiDataList
+}
@@ -0,0 +1,17 @@
+package emoji
+
+// Escape escapes every shortcode in s with a backslash, so that Sprint and the other
+// printing functions output s unchanged. A shortcode preceded by a backslash, such as
+// `\:beer:`, is printed as the literal text ":beer:".
+//
+// Demojize(Escape(s)) converts the emoji in s into shortcodes while keeping the
+// shortcodes already written out in s from being turned into emoji later.
+func Escape(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	sc := scanner{r: defaultReplacer, escape: true}
+	output, _ := sc.scan(make([]byte, 0, len(s)), []byte(s), true)
+	return string(output)
+}
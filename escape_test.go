@@ -0,0 +1,66 @@
+package emoji
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEscapedShortCode(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{`\:beer:`, ":beer:"},
+		{`use \:beer: to get :beer:`, "use :beer: to get " + Emojize(beerKey)},
+		{`\\:beer:`, `\:beer:`},
+		{`\:unknown:`, `\:unknown:`},
+		{`C:\path\:x`, `C:\path\:x`},
+		{`\::beer:`, `\:` + Emojize(beerKey)},
+		{`\`, `\`},
+	}
+	for _, tt := range tests {
+		if actual := Sprint(tt.in); actual != tt.expected {
+			t.Errorf("Sprint(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+}
+
+func TestEscapedShortCodeWriter(t *testing.T) {
+	in := `a \:beer: b \\:beer: c \`
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for i := 0; i < len(in); i++ {
+		if _, err := w.Write([]byte{in[i]}); err != nil {
+			t.Fatal("Write ", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal("Close ", err)
+	}
+	if buf.String() != Sprint(in) {
+		t.Errorf("Writer %q != %q", buf.String(), Sprint(in))
+	}
+}
+
+func TestEscape(t *testing.T) {
+	inputs := []string{
+		"",
+		"no shortcodes",
+		"literal :beer: and :+1:",
+		`already \:beer: escaped`,
+		":unknown: ::smile: :flag-us:",
+	}
+	for _, in := range inputs {
+		if actual := Sprint(Escape(in)); actual != in {
+			t.Errorf("Sprint(Escape(%q)) = %q", in, actual)
+		}
+	}
+}
+
+func TestDemojizeEscape(t *testing.T) {
+	in := "\U0001f37a is written :beer:"
+	expected := `:beer: is written \:beer:`
+	if actual := Demojize(Escape(in)); actual != expected {
+		t.Errorf("Demojize(Escape(%q)) = %q, expected %q", in, actual, expected)
+	}
+}
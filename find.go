@@ -0,0 +1,89 @@
+package emoji
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// MatchKind tells how an emoji was written.
+type MatchKind int
+
+// Kinds of matches.
+const (
+	// MatchUnicode is an emoji sequence, such as "🍺".
+	MatchUnicode MatchKind = iota
+	// MatchShortCode is a shortcode, such as ":beer:".
+	MatchShortCode
+)
+
+// Match is an emoji found by FindAll.
+type Match struct {
+	// Start and End are the byte offsets of Text in the input.
+	Start, End int
+	// Text is the emoji sequence or shortcode as written.
+	Text string
+	Kind MatchKind
+	// ShortCode is the canonical shortcode of the emoji (see NormalizeShortCode).
+	ShortCode string
+	// Emoji is the metadata of the emoji, if known. Image emoji have none.
+	Emoji Emoji
+}
+
+// FindAll returns the emoji sequences and shortcodes in s, in order. Emoji sequences
+// are matched longest first, like in Demojize, and only those with a shortcode are
+// found. Shortcodes are found where Replace would replace them.
+func FindAll(s string) []Match {
+	return defaultReplacer.FindAll(s)
+}
+
+// FindAll is FindAll using the shortcodes and context rules of r.
+func (r *Replacer) FindAll(s string) []Match {
+	trie := currentTables().sequenceTrie()
+
+	finder := *r
+	finder.maxVersion = nil
+	var matches []Match
+	sc := scanner{r: &finder, strip: true, known: func(shortCode string, offset, size int) {
+		code, _, _ := finder.lookup(shortCode)
+		m := Match{
+			Start:     offset,
+			End:       offset + size,
+			Text:      s[offset : offset+size],
+			Kind:      MatchShortCode,
+			ShortCode: shortCode,
+		}
+		if _, ok := finder.images()[shortCode]; ok {
+			m.ShortCode = NormalizeShortCode(shortCode)
+		} else if canonical, n := trie.match(code); n > 0 && n == len(code) {
+			m.ShortCode = canonical
+			m.Emoji, _ = LookupChar(code)
+		}
+		matches = append(matches, m)
+	}}
+	sc.scan(nil, []byte(s), true)
+
+	shortCodes := len(matches)
+	next := 0
+	for i := 0; i < len(s); {
+		if next < shortCodes && i >= matches[next].Start {
+			i = max(i, matches[next].End)
+			next++
+			continue
+		}
+		shortCode, n := trie.match(s[i:])
+		if n == 0 {
+			_, n = utf8.DecodeRuneInString(s[i:])
+			i += n
+			continue
+		}
+		m := Match{Start: i, End: i + n, Text: s[i : i+n], Kind: MatchUnicode, ShortCode: shortCode}
+		m.Emoji, _ = LookupChar(m.Text)
+		matches = append(matches, m)
+		i += n
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Start < matches[j].Start
+	})
+	return matches
+}
@@ -0,0 +1,47 @@
+package emoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindAll(t *testing.T) {
+	s := "hi :beer: \u2764 \U0001f468\u200d\U0001f469\u200d\U0001f467 :+1::skin-tone-3: \\:beer: 12:30: \U0001f1ef\U0001f1f5"
+	type found struct {
+		start, end int
+		text       string
+		kind       MatchKind
+		shortCode  string
+		name       string
+	}
+	expected := []found{
+		{3, 9, ":beer:", MatchShortCode, ":beer:", "beer mug"},
+		{10, 13, "\u2764", MatchUnicode, ":red_heart:", "red heart"},
+		{14, 32, "\U0001f468\u200d\U0001f469\u200d\U0001f467", MatchUnicode, ":family_mwg:", "family: man, woman, girl"},
+		{33, 50, ":+1::skin-tone-3:", MatchShortCode, ":thumbsup_tone2:", "thumbs up: medium-light skin tone"},
+		{66, 74, "\U0001f1ef\U0001f1f5", MatchUnicode, ":jp:", "flag: Japan"},
+	}
+
+	var actual []found
+	for _, m := range FindAll(s) {
+		if s[m.Start:m.End] != m.Text {
+			t.Errorf("FindAll: %q at %d-%d", m.Text, m.Start, m.End)
+		}
+		actual = append(actual, found{m.Start, m.End, m.Text, m.Kind, m.ShortCode, m.Emoji.Name})
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("FindAll(%q) = %v, expected %v", s, actual, expected)
+	}
+
+	if matches := FindAll("no emoji: here"); len(matches) != 0 {
+		t.Errorf("FindAll without emoji = %v", matches)
+	}
+}
+
+func TestFindAllAliases(t *testing.T) {
+	for _, m := range FindAll(":thumbsup: :+1:") {
+		if m.ShortCode != NormalizeShortCode(":+1:") || m.Emoji.Char != "\U0001f44d" {
+			t.Errorf("FindAll: %q has shortcode %q and emoji %q", m.Text, m.ShortCode, m.Emoji.Char)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package emoji
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// isFlagRegion reports whether a lowercase region code such as "us" has a flag.
+func isFlagRegion(code string) bool {
+	_, ok := lookupCountry(code)
+	return ok && len(code) == 2
+}
+
+// isSubdivisionFlag reports whether a lowercase subdivision code such as "gb-eng"
+// has a flag.
+func isSubdivisionFlag(code string) bool {
+	_, ok := lookupCountry(code)
+	return ok && len(code) > 2
+}
+
+const (
+	blackFlag = '\U0001F3F4'
+	tagBase   = '\U000E0000'
+	cancelTag = '\U000E007F'
+)
+
+// flagSequence returns the flag emoji for a region code such as "us" or a
+// subdivision code such as "gb-eng", in any case.
+func flagSequence(code string) (string, bool) {
+	code = strings.ToLower(code)
+	switch {
+	case isFlagRegion(code):
+		return regionalIndicator(code[0]) + regionalIndicator(code[1]), true
+	case isSubdivisionFlag(code):
+		var sb strings.Builder
+		sb.WriteRune(blackFlag)
+		for _, r := range strings.ReplaceAll(code, "-", "") {
+			sb.WriteRune(tagBase + r)
+		}
+		sb.WriteRune(cancelTag)
+		return sb.String(), true
+	}
+	return "", false
+}
+
+// flagFromShortCode returns the flag emoji for a shortcode such as ":flag-us:",
+// ":flag-US:", ":flag-gb-eng:" or ":flag_japan:".
+func flagFromShortCode(shortCode string) (string, bool) {
+	const prefix = ":flag-"
+	if len(shortCode) <= len(prefix)+1 || !strings.HasSuffix(shortCode, ":") ||
+		!strings.EqualFold(shortCode[:len(prefix)-1], prefix[:len(prefix)-1]) {
+		return "", false
+	}
+	if sep := shortCode[len(prefix)-1]; sep != '-' && sep != '_' {
+		return "", false
+	}
+	code := shortCode[len(prefix) : len(shortCode)-1]
+	if flag, ok := flagSequence(code); ok {
+		return flag, true
+	}
+	if c, ok := lookupCountryName(code); ok {
+		return flagSequence(c.code)
+	}
+	return "", false
+}
+
+var maxFlagShortCodeLen int
+var maxFlagShortCodeLenInitOnce = sync.Once{}
+
+// flagShortCodeMaxLen returns the length in bytes of the longest flag shortcode.
+func flagShortCodeMaxLen() int {
+	maxFlagShortCodeLenInitOnce.Do(func() {
+		maxFlagShortCodeLen = len(":flag-gb-eng:")
+		for _, c := range countries {
+			if n := len(":flag_" + countryShortName(c.name) + ":"); n > maxFlagShortCodeLen {
+				maxFlagShortCodeLen = n
+			}
+		}
+	})
+	return maxFlagShortCodeLen
+}
+
+// scanFlag reads a flag emoji from the start of s and returns its region or
+// subdivision code, such as "us" or "gb-eng", and its length in bytes.
+func scanFlag(s string) (string, int) {
+	r, n := utf8.DecodeRuneInString(s)
+	switch {
+	case isRegionalIndicator(r):
+		r2, n2 := utf8.DecodeRuneInString(s[n:])
+		if !isRegionalIndicator(r2) {
+			return "", 0
+		}
+		code := string([]byte{byte(r-'\U0001F1E6') + 'a', byte(r2-'\U0001F1E6') + 'a'})
+		if !isFlagRegion(code) {
+			return "", 0
+		}
+		return code, n + n2
+	case r == blackFlag:
+		var tags []byte
+		for i := n; i < len(s); {
+			r, size := utf8.DecodeRuneInString(s[i:])
+			i += size
+			switch {
+			case r == cancelTag:
+				if len(tags) < 3 {
+					return "", 0
+				}
+				code := string(tags[:2]) + "-" + string(tags[2:])
+				if !isSubdivisionFlag(code) {
+					return "", 0
+				}
+				return code, i
+			case r > tagBase && r < cancelTag:
+				tags = append(tags, byte(r-tagBase))
+			default:
+				return "", 0
+			}
+		}
+	}
+	return "", 0
+}
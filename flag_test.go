@@ -0,0 +1,64 @@
+package emoji
+
+import (
+	"testing"
+)
+
+func TestFlagShortCodes(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{":flag-jp:", "\U0001f1ef\U0001f1f5"},
+		{":flag-JP:", "\U0001f1ef\U0001f1f5"},
+		{":Flag-Jp:", "\U0001f1ef\U0001f1f5"},
+		{":FLAG-US:", "\U0001f1fa\U0001f1f8"},
+		{":flag-zz:", ":flag-zz:"},
+		{":flag-j:", ":flag-j:"},
+		{":flag-usa:", ":flag-usa:"},
+		{":flag-gb-eng:", "\U0001f3f4\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f"},
+		{":flag-GB-SCT:", "\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f"},
+		{":flag-gb-xyz:", ":flag-gb-xyz:"},
+	}
+	for _, tt := range tests {
+		if actual := Emojize(tt.in); actual != tt.expected {
+			t.Errorf("Emojize(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+	if actual := Sprint("go :flag-gb-wls:!"); actual != "go \U0001f3f4\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f!" {
+		t.Errorf("Sprint %q", actual)
+	}
+}
+
+func TestScanFlag(t *testing.T) {
+	tests := []struct {
+		in   string
+		code string
+		size int
+	}{
+		{"\U0001f1ef\U0001f1f5!", "jp", 8},
+		{"\U0001f1ff\U0001f1ff", "", 0},
+		{"\U0001f1ef", "", 0},
+		{"\U0001f3f4\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "gb-eng", 28},
+		{"\U0001f3f4\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067", "", 0},
+		{"\U0001f3f4", "", 0},
+	}
+	for _, tt := range tests {
+		code, size := scanFlag(tt.in)
+		if code != tt.code || size != tt.size {
+			t.Errorf("scanFlag(%q) = %q, %d, expected %q, %d", tt.in, code, size, tt.code, tt.size)
+		}
+	}
+}
+
+func TestDemojizeFlags(t *testing.T) {
+	for _, code := range []string{"jp", "us", "ta", "gb-eng", "gb-sct", "gb-wls"} {
+		flag, ok := flagSequence(code)
+		if !ok {
+			t.Fatalf("flagSequence(%q) failed", code)
+		}
+		if actual := Emojize(Demojize(flag)); actual != flag && actual != flag+ReplacePadding {
+			t.Errorf("Emojize(Demojize(%q)) = %q", flag, actual)
+		}
+	}
+}
@@ -1,3 +1,5 @@
 module github.com/kyokomi/emoji/v2
 
 go 1.21
+
+require golang.org/x/text v0.22.0
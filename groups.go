@@ -0,0 +1,62 @@
+package emoji
+
+import "sync"
+
+// emojiGroups holds the groups and subgroups of emoji-test.txt, in their order.
+var emojiGroups struct {
+	once      sync.Once
+	groups    []string
+	subgroups map[string][]string
+	members   map[string][]*emojiData
+}
+
+func initEmojiGroups() {
+	emojiGroups.subgroups = make(map[string][]string)
+	emojiGroups.members = make(map[string][]*emojiData)
+	table := emojiDataTable()
+	for i := range table {
+		d := &table[i]
+		subgroups, ok := emojiGroups.subgroups[d.group]
+		if !ok {
+			emojiGroups.groups = append(emojiGroups.groups, d.group)
+		}
+		if len(subgroups) == 0 || subgroups[len(subgroups)-1] != d.subgroup {
+			emojiGroups.subgroups[d.group] = append(subgroups, d.subgroup)
+		}
+		if !hasSkinTone(d.char) {
+			emojiGroups.members[d.subgroup] = append(emojiGroups.members[d.subgroup], d)
+		}
+	}
+}
+
+// Groups returns the emoji groups, such as "Smileys & Emotion" and "Food & Drink", in
+// the order of the Unicode emoji charts.
+func Groups() []string {
+	emojiGroups.once.Do(initEmojiGroups)
+	return append([]string(nil), emojiGroups.groups...)
+}
+
+// Subgroups returns the subgroups of group, such as "drink" in "Food & Drink", in the
+// order of the Unicode emoji charts. It returns nil for an unknown group.
+func Subgroups(group string) []string {
+	emojiGroups.once.Do(initEmojiGroups)
+	return append([]string(nil), emojiGroups.subgroups[group]...)
+}
+
+// InSubgroup returns the emoji of a subgroup in the order of the Unicode emoji charts.
+// Variants with skin tones are left out; the emoji they are variants of have
+// SkinToneSupport set. It returns nil for an unknown subgroup.
+func InSubgroup(name string) []Emoji {
+	emojiGroups.once.Do(initEmojiGroups)
+	members := emojiGroups.members[name]
+	if len(members) == 0 {
+		return nil
+	}
+	emojis := make([]Emoji, 0, len(members))
+	for _, d := range members {
+		if e, ok := LookupChar(d.char); ok {
+			emojis = append(emojis, e)
+		}
+	}
+	return emojis
+}
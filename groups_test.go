@@ -0,0 +1,55 @@
+package emoji
+
+import "testing"
+
+func TestGroups(t *testing.T) {
+	groups := Groups()
+	if len(groups) < 9 || groups[0] != "Smileys & Emotion" || groups[len(groups)-1] != "Flags" {
+		t.Errorf("Groups() = %q", groups)
+	}
+	groups[0] = "changed"
+	if Groups()[0] != "Smileys & Emotion" {
+		t.Error("Groups() returned the underlying slice")
+	}
+
+	subgroups := Subgroups("Food & Drink")
+	if len(subgroups) == 0 || subgroups[0] != "food-fruit" {
+		t.Errorf("Subgroups(Food & Drink) = %q", subgroups)
+	}
+	found := false
+	for _, subgroup := range subgroups {
+		found = found || subgroup == "drink"
+	}
+	if !found {
+		t.Errorf("Subgroups(Food & Drink) = %q, expected drink", subgroups)
+	}
+	if subgroups := Subgroups("Unknown"); subgroups != nil {
+		t.Errorf("Subgroups(Unknown) = %q", subgroups)
+	}
+}
+
+func TestInSubgroup(t *testing.T) {
+	drinks := InSubgroup("drink")
+	if len(drinks) == 0 || drinks[0].Name != "baby bottle" {
+		t.Errorf("InSubgroup(drink) = %+v", drinks)
+	}
+	found := false
+	for _, e := range drinks {
+		found = found || e.Char == "\U0001f37a"
+		if e.Group != "Food & Drink" || e.Subgroup != "drink" {
+			t.Errorf("InSubgroup(drink) has %+v", e)
+		}
+	}
+	if !found {
+		t.Error("InSubgroup(drink) has no beer mug")
+	}
+
+	for _, e := range InSubgroup("hand-fingers-closed") {
+		if hasSkinTone(e.Char) {
+			t.Errorf("InSubgroup(hand-fingers-closed) has %q", e.Char)
+		}
+	}
+	if emojis := InSubgroup("unknown"); emojis != nil {
+		t.Errorf("InSubgroup(unknown) = %+v", emojis)
+	}
+}
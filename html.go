@@ -0,0 +1,117 @@
+package emoji
+
+import (
+	"bytes"
+	"html/template"
+	"strings"
+	"unicode/utf8"
+)
+
+// HTMLEmoji is the data passed to the template that renders an emoji as HTML.
+type HTMLEmoji struct {
+	// Char is the emoji itself.
+	Char string
+	// ShortCode is the shortcode that was replaced, such as ":beer:".
+	ShortCode string
+	// Name is the CLDR name of the emoji, such as "beer mug". Emoji without one are
+	// named after their shortcode.
+	Name string
+	// Class is the class set with WithHTMLClass.
+	Class string
+	// URL is the image of an image emoji, and empty for other emoji.
+	URL string
+}
+
+// DefaultHTMLTemplate renders an emoji as an accessible span element.
+var DefaultHTMLTemplate = template.Must(template.New("emoji").Parse(
+	`<span class="{{.Class}}" role="img" aria-label="{{.Name}}" title="{{.ShortCode}}">{{.Char}}</span>`))
+
+// WithHTMLTemplate sets the template HTML uses to render each emoji. It is executed
+// with an HTMLEmoji. The default is DefaultHTMLTemplate.
+func WithHTMLTemplate(t *template.Template) Option {
+	return func(r *Replacer) {
+		r.htmlTemplate = t
+	}
+}
+
+// WithHTMLClass sets the class of the elements HTML renders emoji as. The default
+// is "emoji".
+func WithHTMLClass(class string) Option {
+	return func(r *Replacer) {
+		r.htmlClass = class
+	}
+}
+
+// HTML replaces every shortcode in s with an HTML element, and escapes the text
+// around them, so that the result can be embedded in a web page:
+//
+//	:beer: → <span class="emoji" role="img" aria-label="beer mug" title=":beer:">🍺</span>
+func HTML(s string) string {
+	return defaultReplacer.HTML(s)
+}
+
+// HTML is HTML using the settings of r. Padding is not applied.
+func (r *Replacer) HTML(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	sc := scanner{r: r, html: true}
+	output, _ := sc.scan(make([]byte, 0, len(s)), []byte(s), true)
+	return string(output)
+}
+
+// appendHTML appends the HTML element for an emoji, or for an image emoji if it
+// has a URL.
+func (r *Replacer) appendHTML(dst []byte, e HTMLEmoji) []byte {
+	t := r.htmlTemplate
+	if e.URL != "" {
+		t = r.htmlImageTemplate
+		if t == nil {
+			t = DefaultHTMLImageTemplate
+		}
+	} else if t == nil {
+		t = DefaultHTMLTemplate
+	}
+	e.Name = shortCodeName(e.ShortCode)
+	if d, ok := lookupEmojiData(e.Char); ok && e.URL == "" {
+		e.Name = d.name
+	}
+	e.Class = r.htmlClass
+	if e.Class == "" {
+		e.Class = "emoji"
+	}
+
+	buf := bytes.NewBuffer(dst)
+	if err := t.Execute(buf, e); err != nil {
+		// never leave a broken element behind
+		for _, c := range e.Char {
+			dst = appendEscapedHTML(dst, c)
+		}
+		return dst
+	}
+	return buf.Bytes()
+}
+
+// shortCodeName turns a shortcode into a human readable name: ":beer_mug:" becomes
+// "beer mug".
+func shortCodeName(shortCode string) string {
+	return strings.NewReplacer("_", " ", "-", " ").Replace(strings.Trim(shortCode, ":"))
+}
+
+// appendEscapedHTML appends r escaped like html.EscapeString does.
+func appendEscapedHTML(dst []byte, r rune) []byte {
+	switch r {
+	case '&':
+		return append(dst, "&amp;"...)
+	case '<':
+		return append(dst, "&lt;"...)
+	case '>':
+		return append(dst, "&gt;"...)
+	case '\'':
+		return append(dst, "&#39;"...)
+	case '"':
+		return append(dst, "&#34;"...)
+	}
+	return utf8.AppendRune(dst, r)
+}
@@ -0,0 +1,42 @@
+package emoji
+
+import (
+	"html/template"
+	"testing"
+)
+
+func TestHTML(t *testing.T) {
+	beer := CodeMap()[beerKey]
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"", ""},
+		{":beer:", `<span class="emoji" role="img" aria-label="beer mug" title=":beer:">` + beer + `</span>`},
+		{`<b>"a" & 'b'</b>`, `&lt;b&gt;&#34;a&#34; &amp; &#39;b&#39;&lt;/b&gt;`},
+		{"x<:beer:>", `x&lt;<span class="emoji" role="img" aria-label="beer mug" title=":beer:">` + beer + `</span>&gt;`},
+		{":no_such_code: <", ":no_such_code: &lt;"},
+		{`\:beer:`, ":beer:"},
+		{":flag-us:", `<span class="emoji" role="img" aria-label="flag: United States" title=":flag-us:">` + Emojize(":flag-us:") + `</span>`},
+	}
+	for _, tt := range tests {
+		if actual := HTML(tt.in); actual != tt.expected {
+			t.Errorf("HTML(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+}
+
+func TestHTMLOptions(t *testing.T) {
+	r := NewReplacer(WithHTMLClass("e"), WithPadding("  "))
+	expected := `<span class="e" role="img" aria-label="beer mug" title=":beer:">` + CodeMap()[beerKey] + `</span>!`
+	if actual := r.HTML(":beer:!"); actual != expected {
+		t.Errorf("HTML = %q, expected %q", actual, expected)
+	}
+
+	tmpl := template.Must(template.New("").Parse(`<i class="{{.Class}}" data-code="{{.ShortCode}}">{{.Char}}</i>`))
+	r = NewReplacer(WithHTMLTemplate(tmpl), WithCodeMap(map[string]string{":a&b:": "<x>"}))
+	expected = `<i class="emoji" data-code=":a&amp;b:">&lt;x&gt;</i>`
+	if actual := r.HTML(":a&b:"); actual != expected {
+		t.Errorf("HTML = %q, expected %q", actual, expected)
+	}
+}
@@ -0,0 +1,132 @@
+package emoji
+
+import "strings"
+
+// ReplaceHTML replaces the shortcodes in the text of an HTML fragment. Tags and their
+// attributes, comments, and the content of script, style, textarea, pre and code
+// elements are left alone; everything but the replaced shortcodes is copied byte for
+// byte.
+func ReplaceHTML(s string) string {
+	return defaultReplacer.ReplaceHTML(s)
+}
+
+// ReplaceHTML is ReplaceHTML using the settings of r.
+func (r *Replacer) ReplaceHTML(s string) string {
+	dst := make([]byte, 0, len(s))
+	// codeDepth counts the open pre and code elements
+	codeDepth := 0
+	text := 0
+	for i := 0; i < len(s); {
+		if s[i] != '<' {
+			i++
+			continue
+		}
+		n, name, closing := htmlMarkupLen(s[i:])
+		if n == 0 {
+			i++
+			continue
+		}
+
+		if codeDepth == 0 && text < i {
+			sc := scanner{r: r}
+			dst, _ = sc.scan(dst, []byte(s[text:i]), true)
+		} else {
+			dst = append(dst, s[text:i]...)
+		}
+
+		end := i + n
+		switch name {
+		case "script", "style", "textarea":
+			if !closing {
+				end = rawTextEnd(s, end, name)
+			}
+		case "pre", "code":
+			if !closing {
+				codeDepth++
+			} else if codeDepth > 0 {
+				codeDepth--
+			}
+		}
+		dst = append(dst, s[i:end]...)
+		i, text = end, end
+	}
+	if codeDepth == 0 && text < len(s) {
+		sc := scanner{r: r}
+		dst, _ = sc.scan(dst, []byte(s[text:]), true)
+	} else {
+		dst = append(dst, s[text:]...)
+	}
+	return string(dst)
+}
+
+// htmlMarkupLen returns the length of the tag, comment or declaration that starts t,
+// along with the lowercase name of a tag and whether it is an end tag. It returns 0
+// if the '<' that starts t is text.
+func htmlMarkupLen(t string) (int, string, bool) {
+	if strings.HasPrefix(t, "<!--") {
+		if end := strings.Index(t[4:], "-->"); end >= 0 {
+			return end + 7, "", false
+		}
+		return len(t), "", false
+	}
+	if len(t) < 2 {
+		return 0, "", false
+	}
+	if t[1] == '!' || t[1] == '?' {
+		if end := strings.IndexByte(t, '>'); end >= 0 {
+			return end + 1, "", false
+		}
+		return len(t), "", false
+	}
+
+	closing := t[1] == '/'
+	i := 1
+	if closing {
+		i++
+	}
+	if i == len(t) || !isASCIILetter(t[i]) {
+		return 0, "", false
+	}
+	start := i
+	for i < len(t) && !strings.ContainsRune(" \t\n\r\f/>", rune(t[i])) {
+		i++
+	}
+	name := strings.ToLower(t[start:i])
+
+	for i < len(t) {
+		switch t[i] {
+		case '"', '\'':
+			if end := strings.IndexByte(t[i+1:], t[i]); end >= 0 {
+				i += end + 2
+				continue
+			}
+			return len(t), name, closing
+		case '>':
+			return i + 1, name, closing
+		}
+		i++
+	}
+	return len(t), name, closing
+}
+
+// rawTextEnd returns the end of the end tag of the element name whose content starts
+// at from, or len(s) if it is not closed.
+func rawTextEnd(s string, from int, name string) int {
+	for i := from; ; {
+		j := strings.Index(s[i:], "</")
+		if j < 0 {
+			return len(s)
+		}
+		i += j + 2
+		if len(s)-i < len(name) || !strings.EqualFold(s[i:i+len(name)], name) {
+			continue
+		}
+		i += len(name)
+		if i == len(s) || strings.ContainsRune(" \t\n\r\f/>", rune(s[i])) {
+			if end := strings.IndexByte(s[i:], '>'); end >= 0 {
+				return i + end + 1
+			}
+			return len(s)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package emoji
+
+import "testing"
+
+func TestReplaceHTML(t *testing.T) {
+	beer := Sprint(":beer:")
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"", ""},
+		{":beer:", beer},
+		{`<p title=":beer:">:beer: &amp; :beer:</p>`, `<p title=":beer:">` + beer + ` &amp; ` + beer + `</p>`},
+		{`<a href='/:beer:' title="a > :beer:">x</a>`, `<a href='/:beer:' title="a > :beer:">x</a>`},
+		{"<script>if (a<b) s = ':beer:'</script>:beer:", "<script>if (a<b) s = ':beer:'</script>" + beer},
+		{"<STYLE>a::before{content:':beer:'}</style >:beer:", "<STYLE>a::before{content:':beer:'}</style >" + beer},
+		{"<style>:beer:", "<style>:beer:"},
+		{"<pre><b>:beer:</b><code>:beer:</code>:beer:</pre>:beer:", "<pre><b>:beer:</b><code>:beer:</code>:beer:</pre>" + beer},
+		{"<!-- :beer: -->:beer:<!DOCTYPE html>", "<!-- :beer: -->" + beer + "<!DOCTYPE html>"},
+		{"a < :beer: <3", "a < " + beer + " <3"},
+		{"<p\n  data-x=:beer:>", "<p\n  data-x=:beer:>"},
+	}
+	for _, tt := range tests {
+		if actual := ReplaceHTML(tt.in); actual != tt.expected {
+			t.Errorf("ReplaceHTML(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+}
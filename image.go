@@ -0,0 +1,53 @@
+package emoji
+
+import (
+	"fmt"
+	"html/template"
+)
+
+// ImageEmoji is a custom emoji that is an image rather than a character, such as a
+// team's :partyparrot:.
+type ImageEmoji struct {
+	// URL is the location of the image.
+	URL string
+}
+
+// DefaultHTMLImageTemplate renders an image emoji as an img element.
+var DefaultHTMLImageTemplate = template.Must(template.New("image").Parse(
+	`<img class="{{.Class}}" src="{{.URL}}" alt="{{.ShortCode}}" title="{{.ShortCode}}">`))
+
+// RegisterImage adds a custom shortcode for an image emoji. HTML renders it with an
+// img element; the other functions print the shortcode as is, or the placeholder set
+// with WithImagePlaceholder. Shortcodes registered with the same URL are aliases of
+// each other. RegisterImage is safe for concurrent use.
+func RegisterImage(shortCode string, img ImageEmoji) error {
+	if !validShortCode(shortCode) {
+		return fmt.Errorf("%w: %q", ErrInvalidShortCode, shortCode)
+	}
+	if img.URL == "" {
+		return fmt.Errorf("emoji: image for %s has no URL", shortCode)
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	registry.images[shortCode] = img
+	delete(registry.codes, shortCode)
+	registry.tables.Store(nil)
+	return nil
+}
+
+// WithImagePlaceholder sets the text image emoji are replaced with outside of HTML.
+// By default their shortcode is left as is.
+func WithImagePlaceholder(placeholder string) Option {
+	return func(r *Replacer) {
+		r.imagePlaceholder = &placeholder
+	}
+}
+
+// WithHTMLImageTemplate sets the template HTML uses to render each image emoji. It is
+// executed with an HTMLEmoji. The default is DefaultHTMLImageTemplate.
+func WithHTMLImageTemplate(t *template.Template) Option {
+	return func(r *Replacer) {
+		r.htmlImageTemplate = t
+	}
+}
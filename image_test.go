@@ -0,0 +1,66 @@
+package emoji
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRegisterImage(t *testing.T) {
+	parrot := ImageEmoji{URL: "https://example.com/parrot.gif"}
+	for _, shortCode := range []string{":partyparrot:", ":parrot:", ":beer:"} {
+		if err := RegisterImage(shortCode, parrot); err != nil {
+			t.Fatal("RegisterImage ", err)
+		}
+		defer Unregister(shortCode)
+	}
+
+	if actual := Sprint(":partyparrot: :beer: :smile:"); actual != ":partyparrot: :beer: "+Emojize(":smile:") {
+		t.Errorf("Sprint %q", actual)
+	}
+	if actual := Sprint(`\:partyparrot:`); actual != ":partyparrot:" {
+		t.Errorf("Sprint escaped %q", actual)
+	}
+	if actual := NewReplacer(WithImagePlaceholder("[img]")).Sprint(":partyparrot:!"); actual != "[img]!" {
+		t.Errorf("Sprint with placeholder %q", actual)
+	}
+
+	expected := `<img class="emoji" src="https://example.com/parrot.gif" alt=":partyparrot:" title=":partyparrot:"> &amp;`
+	if actual := HTML(":partyparrot: &"); actual != expected {
+		t.Errorf("HTML = %q, expected %q", actual, expected)
+	}
+
+	aliases := AliasList(":partyparrot:")
+	if len(aliases) != 3 || aliases[0] != ":beer:" || aliases[1] != ":parrot:" || aliases[2] != ":partyparrot:" {
+		t.Errorf("AliasList(:partyparrot:) = %q", aliases)
+	}
+	if !HasAlias(":parrot:") {
+		t.Error("HasAlias(:parrot:) = false")
+	}
+	if _, ok := CodeMap()[":beer:"]; ok {
+		t.Error("CodeMap still has :beer:")
+	}
+
+	if err := Register(":parrot:", "\U0001f99c"); err != nil {
+		t.Fatal("Register ", err)
+	}
+	if actual := Sprint(":parrot:"); actual != "\U0001f99c " {
+		t.Errorf("Sprint after Register %q", actual)
+	}
+	if aliases := AliasList(":partyparrot:"); len(aliases) != 2 {
+		t.Errorf("AliasList after Register %q", aliases)
+	}
+
+	Unregister(":beer:")
+	if actual := Sprint(":beer:"); actual != "\U0001f37a " {
+		t.Errorf("Sprint after Unregister %q", actual)
+	}
+}
+
+func TestRegisterImageInvalid(t *testing.T) {
+	if err := RegisterImage("parrot", ImageEmoji{URL: "parrot.gif"}); !errors.Is(err, ErrInvalidShortCode) {
+		t.Errorf("RegisterImage error %v", err)
+	}
+	if err := RegisterImage(":parrot:", ImageEmoji{}); err == nil {
+		t.Error("RegisterImage without URL expected error")
+	}
+}
@@ -0,0 +1,156 @@
+package emoji
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Graphemes splits s into the characters a reader sees: emoji sequences, such as ZWJ
+// sequences, flags and emoji with a skin tone, characters with their combining marks
+// and variation selectors, and "\r\n". ANSI escape sequences come out on their own.
+func Graphemes(s string) []string {
+	trie := currentTables().sequenceTrie()
+
+	var graphemes []string
+	for i := 0; i < len(s); {
+		n := graphemeLen(trie, s[i:])
+		graphemes = append(graphemes, s[i:i+n])
+		i += n
+	}
+	return graphemes
+}
+
+// graphemeLen returns the length in bytes of the grapheme at the start of s, which
+// must not be empty.
+func graphemeLen(trie *emojiTrie, s string) int {
+	if n := ansiEscapeLen(s); n > 0 {
+		return n
+	}
+	if strings.HasPrefix(s, "\r\n") {
+		return 2
+	}
+
+	r, n := utf8.DecodeRuneInString(s)
+	next, size := utf8.DecodeRuneInString(s[n:])
+	if isRegionalIndicator(r) && isRegionalIndicator(next) {
+		// a pair of regional indicators is a flag, even an unknown one
+		n += size
+	} else {
+		n = max(n, emojiSequenceLen(trie, s))
+	}
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		if !isGraphemeExtend(r) {
+			break
+		}
+		n += size
+	}
+	return n
+}
+
+// isGraphemeExtend reports whether r belongs to the grapheme of the character before
+// it, as combining marks, joiners, variation selectors and skin tone modifiers do.
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) || r == zeroWidthJoiner ||
+		r == '\u200c' || skinToneOf(r) != NoSkinTone || isTag(r)
+}
+
+// Truncate shortens s to at most width columns, as measured by StringWidth, ending it
+// with ellipsis if it is cut. It cuts between graphemes, so emoji sequences are never
+// split, and keeps the ANSI escape sequences after the cut, so that colors are reset.
+// If ellipsis does not fit in width, s is cut without it.
+func Truncate(s string, width int, ellipsis string) string {
+	if StringWidth(s) <= width {
+		return s
+	}
+	ellipsisWidth := StringWidth(ellipsis)
+	if ellipsisWidth > width {
+		ellipsis, ellipsisWidth = "", 0
+	}
+
+	trie := currentTables().sequenceTrie()
+	var sb strings.Builder
+	w := 0
+	cut := false
+	for i := 0; i < len(s); {
+		n := graphemeLen(trie, s[i:])
+		g := s[i : i+n]
+		i += n
+		if ansiEscapeLen(g) > 0 {
+			sb.WriteString(g)
+			continue
+		}
+		if !cut {
+			if gw := StringWidth(g); w+gw <= width-ellipsisWidth {
+				sb.WriteString(g)
+				w += gw
+				continue
+			}
+			sb.WriteString(ellipsis)
+			cut = true
+		}
+	}
+	return sb.String()
+}
+
+// PadRight appends spaces to s until it is width columns wide, as measured by
+// StringWidth. s is returned as is if it is at least that wide.
+func PadRight(s string, width int) string {
+	if w := StringWidth(s); w < width {
+		return s + strings.Repeat(" ", width-w)
+	}
+	return s
+}
+
+// Wrap breaks the lines of s at spaces so that they are at most width columns wide,
+// as measured by StringWidth. Words wider than width are broken between graphemes, so
+// emoji sequences are never split. The spaces where lines are broken are removed. A
+// width of 0 or less leaves s as is.
+func Wrap(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+
+	trie := currentTables().sequenceTrie()
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i, line := range strings.Split(s, "\n") {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		lineWidth := 0
+		for j, word := range strings.Split(line, " ") {
+			wordWidth := StringWidth(word)
+			switch {
+			case j == 0:
+			case lineWidth+1+wordWidth <= width:
+				sb.WriteByte(' ')
+				lineWidth++
+			default:
+				sb.WriteByte('\n')
+				lineWidth = 0
+			}
+			if lineWidth+wordWidth <= width {
+				sb.WriteString(word)
+				lineWidth += wordWidth
+				continue
+			}
+
+			// break the word between graphemes
+			for k := 0; k < len(word); {
+				n := graphemeLen(trie, word[k:])
+				g := word[k : k+n]
+				k += n
+				gw := StringWidth(g)
+				if lineWidth > 0 && lineWidth+gw > width {
+					sb.WriteByte('\n')
+					lineWidth = 0
+				}
+				sb.WriteString(g)
+				lineWidth += gw
+			}
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,93 @@
+package emoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+const womanTechnologist = "\U0001f469\u200d\U0001f4bb"
+
+func TestGraphemes(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected []string
+	}{
+		{"", nil},
+		{"ab", []string{"a", "b"}},
+		{"hi " + womanTechnologist + "!", []string{"h", "i", " ", womanTechnologist, "!"}},
+		{"\U0001f44b\U0001f3fd\U0001f1ef\U0001f1f5", []string{"\U0001f44b\U0001f3fd", "\U0001f1ef\U0001f1f5"}},
+		{"\U0001f1ff\U0001f1ff\U0001f1e6", []string{"\U0001f1ff\U0001f1ff", "\U0001f1e6"}},
+		{"é❤\ufe0f#\ufe0f\u20e3", []string{"é", "❤\ufe0f", "#\ufe0f\u20e3"}},
+		{"e\u0301\u0323x", []string{"e\u0301\u0323", "x"}},
+		{"a\r\nb", []string{"a", "\r\n", "b"}},
+		{"\x1b[31mx\x1b[0m", []string{"\x1b[31m", "x", "\x1b[0m"}},
+	}
+	for _, tt := range tests {
+		if actual := Graphemes(tt.in); !reflect.DeepEqual(actual, tt.expected) {
+			t.Errorf("Graphemes(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		in       string
+		width    int
+		ellipsis string
+		expected string
+	}{
+		{"hello", 5, "...", "hello"},
+		{"hello world", 8, "...", "hello..."},
+		{"ab" + womanTechnologist + "cd", 3, "", "ab"},
+		{"ab" + womanTechnologist + "cd", 4, "", "ab" + womanTechnologist},
+		{"ab" + womanTechnologist + "cd", 5, "…", "ab" + womanTechnologist + "…"},
+		{"\U0001f44b\U0001f3fd\U0001f44b\U0001f3fd", 3, "", "\U0001f44b\U0001f3fd"},
+		{"\x1b[31mred text\x1b[0m", 4, ".", "\x1b[31mred.\x1b[0m"},
+		{"hello", 2, "...", "he"},
+	}
+	for _, tt := range tests {
+		if actual := Truncate(tt.in, tt.width, tt.ellipsis); actual != tt.expected {
+			t.Errorf("Truncate(%q, %d, %q) = %q, expected %q", tt.in, tt.width, tt.ellipsis, actual, tt.expected)
+		}
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		in       string
+		width    int
+		expected string
+	}{
+		{"ship it now", 7, "ship it\nnow"},
+		{"ship it now", 0, "ship it now"},
+		{"a " + womanTechnologist + " b", 3, "a\n" + womanTechnologist + "\nb"},
+		{"\U0001f37a\U0001f37a\U0001f37a", 4, "\U0001f37a\U0001f37a\n\U0001f37a"},
+		{"\U0001f37a\U0001f37a", 1, "\U0001f37a\n\U0001f37a"},
+		{"one two\nthree four", 5, "one\ntwo\nthree\nfour"},
+		{"abcdefgh ij", 3, "abc\ndef\ngh\nij"},
+	}
+	for _, tt := range tests {
+		if actual := Wrap(tt.in, tt.width); actual != tt.expected {
+			t.Errorf("Wrap(%q, %d) = %q, expected %q", tt.in, tt.width, actual, tt.expected)
+		}
+	}
+}
+
+func TestPadRight(t *testing.T) {
+	tests := []struct {
+		in       string
+		width    int
+		expected string
+	}{
+		{"ab", 4, "ab  "},
+		{"\U0001f37a", 4, "\U0001f37a  "},
+		{womanTechnologist, 3, womanTechnologist + " "},
+		{"\x1b[1mb\x1b[0m", 2, "\x1b[1mb\x1b[0m "},
+		{"abcd", 2, "abcd"},
+	}
+	for _, tt := range tests {
+		if actual := PadRight(tt.in, tt.width); actual != tt.expected {
+			t.Errorf("PadRight(%q, %d) = %q, expected %q", tt.in, tt.width, actual, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package emoji
+
+import "strings"
+
+// Markdown replaces the shortcodes in Markdown text, like Sprint, but leaves code
+// alone: code spans, fenced and indented code blocks, autolinks, inline HTML tags,
+// link destinations and reference definitions are copied as is. Prose, headings,
+// lists, tables and link texts are emojized.
+func Markdown(s string) string {
+	return defaultReplacer.Markdown(s)
+}
+
+// Markdown is Markdown using the settings of r.
+func (r *Replacer) Markdown(s string) string {
+	m := markdown{r: r, dst: make([]byte, 0, len(s))}
+	m.blocks(s)
+	return string(m.dst)
+}
+
+// markdown splits Markdown into the parts to emojize and the parts to copy. It
+// recognizes just enough of CommonMark to find code and links.
+type markdown struct {
+	r   *Replacer
+	dst []byte
+}
+
+// blocks splits s into lines and sorts them into blocks. Paragraphs are collected
+// before their inlines are processed, as code spans may continue on the next line.
+func (m *markdown) blocks(s string) {
+	var (
+		fence      string // the opening fence of the open fenced code block
+		paraStart  = -1   // the start of the open paragraph
+		listIndent int    // the column of the content of the open list item
+		lastBlank  = true
+	)
+	flush := func(end int) {
+		if paraStart >= 0 {
+			m.inline(s[paraStart:end])
+			paraStart = -1
+		}
+	}
+
+	for start := 0; start < len(s); {
+		end := strings.IndexByte(s[start:], '\n') + start + 1
+		if end == start {
+			end = len(s)
+		}
+		line := s[start:end]
+		indent, rest := markdownIndent(line)
+		blank := strings.TrimSpace(line) == ""
+
+		switch {
+		case fence != "":
+			m.verbatim(line)
+			if isClosingFence(rest, fence) {
+				fence = ""
+			}
+		case blank:
+			flush(start)
+			m.verbatim(line)
+		case paraStart < 0 && indent >= listIndent+4:
+			// an indented code block
+			m.verbatim(line)
+		default:
+			if lastBlank && indent < listIndent && listItemWidth(rest) == 0 {
+				listIndent = 0
+			}
+			if f := openingFence(rest); f != "" {
+				flush(start)
+				fence = f
+				m.verbatim(line)
+				break
+			}
+			if isATXHeading(rest) {
+				flush(start)
+				m.inline(line)
+				break
+			}
+			if paraStart < 0 && isLinkReferenceDefinition(rest) {
+				m.verbatim(line)
+				break
+			}
+			if w := listItemWidth(rest); w > 0 {
+				flush(start)
+				listIndent = indent + w
+			}
+			if paraStart < 0 {
+				paraStart = start
+			}
+		}
+		lastBlank = blank
+		start = end
+	}
+	flush(len(s))
+}
+
+// inline emojizes the text of a paragraph or heading, except for code spans, escapes,
+// autolinks, HTML tags, link destinations and reference labels.
+func (m *markdown) inline(text string) {
+	prose := 0
+	for i := 0; i < len(text); {
+		skip := 0
+		switch text[i] {
+		case '\\':
+			if i+1 < len(text) && isASCIIPunct(text[i+1]) {
+				skip = 2
+			}
+		case '`':
+			if skip = codeSpanLen(text[i:]); skip == 0 {
+				// a run of backticks without a closing run is literal
+				i += backtickRunLen(text[i:])
+				continue
+			}
+		case '<':
+			skip = autolinkOrTagLen(text[i:])
+		case ']':
+			skip = linkTailLen(text[i:])
+		}
+		if skip == 0 {
+			i++
+			continue
+		}
+		m.emojize(text[prose:i])
+		m.verbatim(text[i : i+skip])
+		i += skip
+		prose = i
+	}
+	m.emojize(text[prose:])
+}
+
+func (m *markdown) emojize(text string) {
+	if text == "" {
+		return
+	}
+	sc := scanner{r: m.r}
+	m.dst, _ = sc.scan(m.dst, []byte(text), true)
+}
+
+func (m *markdown) verbatim(text string) {
+	m.dst = append(m.dst, text...)
+}
+
+// markdownIndent returns the column of the first character of line that is not a
+// space or tab, and the line from that character on.
+func markdownIndent(line string) (int, string) {
+	col := 0
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case ' ':
+			col++
+		case '\t':
+			col += 4 - col%4
+		default:
+			return col, line[i:]
+		}
+	}
+	return col, ""
+}
+
+// openingFence returns the fence that opens a fenced code block, or "".
+func openingFence(rest string) string {
+	if rest == "" || rest[0] != '`' && rest[0] != '~' {
+		return ""
+	}
+	n := len(rest) - len(strings.TrimLeft(rest, rest[:1]))
+	if n < 3 || rest[0] == '`' && strings.Contains(rest[n:], "`") {
+		return ""
+	}
+	return rest[:n]
+}
+
+// isClosingFence reports whether rest closes the code block opened by fence.
+func isClosingFence(rest, fence string) bool {
+	trimmed := strings.TrimLeft(rest, fence[:1])
+	return len(rest)-len(trimmed) >= len(fence) && strings.TrimSpace(trimmed) == ""
+}
+
+func isATXHeading(rest string) bool {
+	n := len(rest) - len(strings.TrimLeft(rest, "#"))
+	if n == 0 || n > 6 {
+		return false
+	}
+	return n == len(rest) || rest[n] == ' ' || rest[n] == '\t' || rest[n] == '\n' || rest[n] == '\r'
+}
+
+// isLinkReferenceDefinition reports whether rest starts like "[label]: destination".
+func isLinkReferenceDefinition(rest string) bool {
+	if !strings.HasPrefix(rest, "[") {
+		return false
+	}
+	end := strings.Index(rest, "]:")
+	return end > 1 && !strings.ContainsAny(rest[1:end], "[]")
+}
+
+// listItemWidth returns the width of the list marker that starts rest, including the
+// spaces after it, or 0 if rest is not a list item.
+func listItemWidth(rest string) int {
+	n := 0
+	switch {
+	case rest == "":
+		return 0
+	case rest[0] == '-' || rest[0] == '*' || rest[0] == '+':
+		n = 1
+	default:
+		for n < len(rest) && n < 9 && rest[n] >= '0' && rest[n] <= '9' {
+			n++
+		}
+		if n == 0 || n == len(rest) || rest[n] != '.' && rest[n] != ')' {
+			return 0
+		}
+		n++
+	}
+	spaces := len(rest[n:]) - len(strings.TrimLeft(rest[n:], " "))
+	switch {
+	case spaces == 0:
+		return 0
+	case spaces > 4:
+		// the content is an indented code block
+		spaces = 1
+	}
+	return n + spaces
+}
+
+func isASCIIPunct(c byte) bool {
+	return c >= '!' && c <= '/' || c >= ':' && c <= '@' || c >= '[' && c <= '`' || c >= '{' && c <= '~'
+}
+
+func backtickRunLen(text string) int {
+	return len(text) - len(strings.TrimLeft(text, "`"))
+}
+
+// codeSpanLen returns the length of the code span that starts text, or 0 if the
+// backticks that start text are not closed by a run of the same length.
+func codeSpanLen(text string) int {
+	n := backtickRunLen(text)
+	for i := n; i < len(text); {
+		j := strings.IndexByte(text[i:], '`')
+		if j < 0 {
+			return 0
+		}
+		i += j
+		run := backtickRunLen(text[i:])
+		if run == n {
+			return i + run
+		}
+		i += run
+	}
+	return 0
+}
+
+// autolinkOrTagLen returns the length of the autolink, such as <https://example.com>,
+// or HTML tag that starts text, or 0.
+func autolinkOrTagLen(text string) int {
+	end := strings.IndexAny(text[1:], "<>") + 1
+	if end == 0 || text[end] != '>' {
+		return 0
+	}
+	content := text[1:end]
+	switch {
+	case content == "":
+		return 0
+	case !strings.ContainsAny(content, " \t\n") && (isURIScheme(content) || strings.Contains(content, "@")):
+		return end + 1
+	case content[0] == '!' || content[0] == '?':
+		return end + 1
+	}
+	name := strings.TrimPrefix(content, "/")
+	if name == "" || !isASCIILetter(name[0]) {
+		return 0
+	}
+	for i := 1; i < len(name); i++ {
+		c := name[i]
+		if !isASCIILetter(c) && !(c >= '0' && c <= '9') && c != '-' {
+			if c != ' ' && c != '\t' && c != '\n' && c != '/' {
+				return 0
+			}
+			break
+		}
+	}
+	return end + 1
+}
+
+// isURIScheme reports whether s starts with the scheme of an absolute URI.
+func isURIScheme(s string) bool {
+	colon := strings.IndexByte(s, ':')
+	if colon < 2 || colon > 32 || !isASCIILetter(s[0]) {
+		return false
+	}
+	for i := 1; i < colon; i++ {
+		c := s[i]
+		if !isASCIILetter(c) && !(c >= '0' && c <= '9') && c != '+' && c != '.' && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// linkTailLen returns the length of the end of a link that starts text: the closing
+// bracket of the link text, followed by a destination in parentheses or a reference
+// label in brackets. It returns 0 if text does not start like that.
+func linkTailLen(text string) int {
+	if len(text) < 2 {
+		return 0
+	}
+	switch text[1] {
+	case '(':
+		depth := 0
+		for i := 1; i < len(text); i++ {
+			switch text[i] {
+			case '\\':
+				i++
+			case '(':
+				depth++
+			case ')':
+				if depth--; depth == 0 {
+					return i + 1
+				}
+			}
+		}
+	case '[':
+		if end := strings.IndexAny(text[2:], "[]"); end >= 0 && text[2+end] == ']' {
+			return end + 3
+		}
+	}
+	return 0
+}
@@ -0,0 +1,38 @@
+package emoji
+
+import "testing"
+
+func TestMarkdown(t *testing.T) {
+	beer := Sprint(":beer:")
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"", ""},
+		{"a :beer: b", "a " + beer + " b"},
+		{"# :beer:\n", "# " + beer + "\n"},
+		{"use `:beer:` for :beer:", "use `:beer:` for " + beer},
+		{"``a ` :beer:`` :beer:", "``a ` :beer:`` " + beer},
+		{"` :beer:", "` " + beer},
+		{"`a\n:beer:` :beer:", "`a\n:beer:` " + beer},
+		{"```go\n:beer:\n```\n:beer:", "```go\n:beer:\n```\n" + beer},
+		{"~~~~\n:beer:\n~~~\n:beer:\n~~~~\n:beer:", "~~~~\n:beer:\n~~~\n:beer:\n~~~~\n" + beer},
+		{"    :beer:\n\n:beer:", "    :beer:\n\n" + beer},
+		{"para\n    :beer:", "para\n    " + beer},
+		{"- :beer:\n\n  :beer:\n\n      :beer:\n", "- " + beer + "\n\n  " + beer + "\n\n      :beer:\n"},
+		{"- item\n\n  ```\n  :beer:\n  ```\n", "- item\n\n  ```\n  :beer:\n  ```\n"},
+		{"[:beer:](http://example.com/:beer:) :beer:", "[" + beer + "](http://example.com/:beer:) " + beer},
+		{"[:beer:](a_(b):beer:)", "[" + beer + "](a_(b):beer:)"},
+		{"[:beer:][:beer:]\n\n[:beer:]: http://example.com/:beer:\n", "[" + beer + "][:beer:]\n\n[:beer:]: http://example.com/:beer:\n"},
+		{"<http://example.com/:beer:> :beer:", "<http://example.com/:beer:> " + beer},
+		{`<span title=":beer:">:beer:</span>`, `<span title=":beer:">` + beer + `</span>`},
+		{"a < :beer: > b", "a < " + beer + " > b"},
+		{"| :beer: | `:beer:` |\n|---|---|\n", "| " + beer + " | `:beer:` |\n|---|---|\n"},
+		{`\:beer: \` + "`:beer:`", `\:beer: \` + "`" + beer + "`"},
+	}
+	for _, tt := range tests {
+		if actual := Markdown(tt.in); actual != tt.expected {
+			t.Errorf("Markdown(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package emoji
+
+import (
+	"strings"
+	"sync"
+)
+
+// Emoji describes an emoji.
+type Emoji struct {
+	// Char is the emoji, fully qualified: with the variation selectors that request
+	// emoji presentation.
+	Char string
+	// Codepoints are the characters of Char.
+	Codepoints []rune
+	// Shortcodes are the shortcodes of the emoji, canonical shortcode first.
+	Shortcodes []string
+	// Name is the CLDR short name, such as "beer mug".
+	Name string
+	// Group and Subgroup are the categories of emoji-test.txt, such as "Food & Drink"
+	// and "drink".
+	Group    string
+	Subgroup string
+	// Keywords are words the emoji can be searched by.
+	Keywords []string
+	// UnicodeVersion is the emoji version that introduced the emoji, such as "1.0".
+	UnicodeVersion string
+	// SkinToneSupport reports whether the emoji has variants with skin tones.
+	SkinToneSupport bool
+}
+
+// Lookup returns the emoji of a shortcode, such as ":beer:". Skin tone and flag
+// shortcodes are supported like in Emojize.
+func Lookup(shortCode string) (Emoji, bool) {
+	if _, ok := currentTables().images[shortCode]; ok {
+		return Emoji{}, false
+	}
+	code, _, ok := defaultReplacer.lookup(shortCode)
+	if !ok {
+		return Emoji{}, false
+	}
+	return LookupChar(code)
+}
+
+// LookupChar returns the emoji s consists of. s may be written with or without
+// variation selectors, so "\u2764" and "\u2764\ufe0f" are both the red heart.
+func LookupChar(s string) (Emoji, bool) {
+	t := currentTables()
+	d, ok := lookupEmojiData(s)
+	e := Emoji{Char: s}
+	if ok {
+		e = Emoji{
+			Char:            d.char,
+			Name:            d.name,
+			Group:           d.group,
+			Subgroup:        d.subgroup,
+			Keywords:        d.keywords,
+			UnicodeVersion:  d.version,
+			SkinToneSupport: d.skinTone,
+		}
+	}
+
+	// the shortcodes may be listed for another qualification of the emoji
+	seen := make(map[string]bool)
+	for _, code := range []string{s, e.Char, dataKey(s)} {
+		if seen[code] {
+			continue
+		}
+		seen[code] = true
+		e.Shortcodes = append(e.Shortcodes, t.revCodeMap[code]...)
+	}
+	if code, n := scanFlag(s); len(e.Shortcodes) == 0 && n > 0 && n == len(s) {
+		e.Shortcodes = []string{":flag-" + code + ":"}
+	}
+	if !ok {
+		if len(e.Shortcodes) == 0 {
+			return Emoji{}, false
+		}
+		e.SkinToneSupport = takesSkinTone(s)
+	}
+	e.Codepoints = []rune(e.Char)
+	return e, true
+}
+
+// emojiData is the metadata of an emoji, generated from emoji-test.txt and the
+// keywords of the emoji databases.
+type emojiData struct {
+	char     string
+	name     string
+	group    string
+	subgroup string
+	keywords []string
+	version  string
+	skinTone bool
+}
+
+var emojiDataByChar map[string]*emojiData
+var emojiDataByCharInitOnce = sync.Once{}
+
+// lookupEmojiData returns the metadata of an emoji, which may be written with or
+// without variation selectors.
+func lookupEmojiData(char string) (*emojiData, bool) {
+	emojiDataByCharInitOnce.Do(func() {
+		table := emojiDataTable()
+		emojiDataByChar = make(map[string]*emojiData, len(table))
+		for i := range table {
+			emojiDataByChar[dataKey(table[i].char)] = &table[i]
+		}
+	})
+	d, ok := emojiDataByChar[dataKey(char)]
+	return d, ok
+}
+
+// dataKey returns the key of an emoji in emojiDataByChar.
+func dataKey(char string) string {
+	return strings.ReplaceAll(char, string(variationSelector16), "")
+}
@@ -0,0 +1,57 @@
+package emoji
+
+import "testing"
+
+func TestLookup(t *testing.T) {
+	e, ok := Lookup(":beer:")
+	if !ok {
+		t.Fatal("Lookup(:beer:) not found")
+	}
+	if e.Char != "\U0001f37a" || len(e.Codepoints) != 1 || e.Codepoints[0] != 0x1f37a {
+		t.Errorf("Lookup(:beer:) Char %q Codepoints %U", e.Char, e.Codepoints)
+	}
+	if e.Name != "beer mug" || e.Group != "Food & Drink" || e.Subgroup != "drink" || e.UnicodeVersion != "0.6" {
+		t.Errorf("Lookup(:beer:) = %+v", e)
+	}
+	if len(e.Shortcodes) == 0 || e.Shortcodes[0] != ":beer:" || e.SkinToneSupport {
+		t.Errorf("Lookup(:beer:) = %+v", e)
+	}
+
+	if e, ok := Lookup(":+1:"); !ok || e.Name != "thumbs up" || !e.SkinToneSupport {
+		t.Errorf("Lookup(:+1:) = %+v", e)
+	}
+	if e, ok := Lookup(":+1_tone2:"); !ok || e.Name != "thumbs up: medium-light skin tone" || e.SkinToneSupport {
+		t.Errorf("Lookup(:+1_tone2:) = %+v", e)
+	}
+	if e, ok := Lookup(":flag-jp:"); !ok || e.Name != "flag: Japan" || e.Group != "Flags" {
+		t.Errorf("Lookup(:flag-jp:) = %+v", e)
+	}
+	if _, ok := Lookup(":xyzzy:"); ok {
+		t.Error("Lookup(:xyzzy:) found")
+	}
+}
+
+func TestLookupChar(t *testing.T) {
+	for _, s := range []string{"\u2764", "\u2764\ufe0f"} {
+		e, ok := LookupChar(s)
+		if !ok || e.Char != "\u2764\ufe0f" || e.Name != "red heart" || len(e.Shortcodes) == 0 {
+			t.Errorf("LookupChar(%q) = %+v", s, e)
+		}
+	}
+	if e, ok := LookupChar("\U0001f3fd"); !ok || e.Group != "Component" {
+		t.Errorf("LookupChar(skin tone) = %+v", e)
+	}
+	for _, s := range []string{"", "a", "\U0001f37a\U0001f37a"} {
+		if _, ok := LookupChar(s); ok {
+			t.Errorf("LookupChar(%q) found", s)
+		}
+	}
+
+	if err := Register(":dodo:", "\U0001f9a4"); err != nil {
+		t.Fatal("Register ", err)
+	}
+	defer Unregister(":dodo:")
+	if e, ok := LookupChar("\U0001f9a4"); !ok || e.Shortcodes[len(e.Shortcodes)-1] != ":dodo:" {
+		t.Errorf("LookupChar(dodo) = %+v", e)
+	}
+}
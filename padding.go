@@ -0,0 +1,78 @@
+package emoji
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+// EndOfInput is the next rune passed to a PaddingPolicy when an emoji ends the input.
+const EndOfInput rune = -1
+
+// PaddingPolicy decides what is written after an emoji that replaced a shortcode.
+type PaddingPolicy interface {
+	// Padding returns the padding for emoji, which is followed by next in the input.
+	Padding(emoji string, next rune) string
+}
+
+// PaddingFunc adapts an ordinary function to a PaddingPolicy.
+type PaddingFunc func(emoji string, next rune) string
+
+// Padding calls f(emoji, next).
+func (f PaddingFunc) Padding(emoji string, next rune) string {
+	return f(emoji, next)
+}
+
+// FixedPadding pads every emoji with padding.
+func FixedPadding(padding string) PaddingPolicy {
+	return PaddingFunc(func(string, rune) string {
+		return padding
+	})
+}
+
+// WidthPadding pads emoji that are displayed with emoji presentation, two columns
+// wide, with padding. Characters displayed as text, like :trade_mark:, are not padded,
+// and neither is an emoji followed by whitespace, punctuation or the end of input.
+func WidthPadding(padding string) PaddingPolicy {
+	return PaddingFunc(func(emoji string, next rune) string {
+		if next == EndOfInput || unicode.IsSpace(next) || unicode.IsPunct(next) {
+			return ""
+		}
+		if !isWide(emoji) {
+			return ""
+		}
+		return padding
+	})
+}
+
+// packagePadding pads with the current value of ReplacePadding.
+type packagePadding struct{}
+
+func (packagePadding) Padding(string, rune) string {
+	return ReplacePadding
+}
+
+// isWide reports whether an emoji is displayed with emoji presentation. That is the
+// case when its first character is followed by VS16, when it is a flag made of
+// regional indicators, or when its first character is East Asian Wide, as all
+// characters with the Emoji_Presentation property besides regional indicators are.
+func isWide(emoji string) bool {
+	r, n := utf8.DecodeRuneInString(emoji)
+	if next, _ := utf8.DecodeRuneInString(emoji[n:]); next == variationSelector16 {
+		return true
+	}
+	if isRegionalIndicator(r) {
+		return true
+	}
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return true
+	}
+	return false
+}
+
+// isRegionalIndicator reports whether r is one of the letters used in flag sequences.
+func isRegionalIndicator(r rune) bool {
+	return r >= '\U0001F1E6' && r <= '\U0001F1FF'
+}
@@ -0,0 +1,46 @@
+package emoji
+
+import (
+	"testing"
+)
+
+func TestWidthPadding(t *testing.T) {
+	r := NewReplacer(WithPaddingPolicy(WidthPadding(" ")))
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{":beer:\u30d3\u30fc\u30eb", "\U0001f37a \u30d3\u30fc\u30eb"},
+		{":beer: beer", "\U0001f37a beer"},
+		{":beer:, beer", "\U0001f37a, beer"},
+		{":beer:", "\U0001f37a"},
+		{":smiling_face:\u30d3", "\u263a\u30d3"},
+		{":relaxed:\u30d3", "\u263a\ufe0f \u30d3"},
+		{":us:\u30d3", "\U0001f1fa\U0001f1f8 \u30d3"},
+		{":hash:\u30d3", "#\ufe0f\u20e3 \u30d3"},
+	}
+	for _, tt := range tests {
+		if actual := r.Replace(tt.in); actual != tt.expected {
+			t.Errorf("Replace(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+}
+
+func TestFixedPadding(t *testing.T) {
+	r := NewReplacer(WithPaddingPolicy(FixedPadding("_")))
+	if actual := r.Replace(":smiling_face: :beer:"); actual != "\u263a_ \U0001f37a_" {
+		t.Errorf("Replace %q", actual)
+	}
+}
+
+func TestPaddingFunc(t *testing.T) {
+	var nexts []rune
+	r := NewReplacer(WithPaddingPolicy(PaddingFunc(func(emoji string, next rune) string {
+		nexts = append(nexts, next)
+		return ""
+	})))
+	r.Replace(":beer:\u30d3 :beer:")
+	if len(nexts) != 2 || nexts[0] != '\u30d3' || nexts[1] != EndOfInput {
+		t.Errorf("PaddingFunc called with %q", nexts)
+	}
+}
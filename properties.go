@@ -0,0 +1,79 @@
+package emoji
+
+import (
+	"strconv"
+	"sync"
+	"unicode"
+)
+
+// Property is an emoji property of Unicode's emoji-data.txt.
+type Property int
+
+// Emoji properties. See https://www.unicode.org/reports/tr51/#Emoji_Properties.
+const (
+	// PropertyEmoji is set for characters that are emoji, including "#", "*" and
+	// the digits, which are emoji in keycaps.
+	PropertyEmoji Property = iota
+	// PropertyEmojiPresentation is set for emoji shown as emoji by default, rather
+	// than as text.
+	PropertyEmojiPresentation
+	// PropertyEmojiModifier is set for the skin tone modifiers.
+	PropertyEmojiModifier
+	// PropertyEmojiModifierBase is set for the emoji a skin tone modifier applies to.
+	PropertyEmojiModifierBase
+	// PropertyEmojiComponent is set for the characters emoji sequences are made of
+	// that are not emoji on their own, such as ZWJ and the tags.
+	PropertyEmojiComponent
+	// PropertyExtendedPictographic is set for emoji and the code points reserved for
+	// future emoji.
+	PropertyExtendedPictographic
+)
+
+var propertyNames = [...]string{
+	PropertyEmoji:                "Emoji",
+	PropertyEmojiPresentation:    "Emoji_Presentation",
+	PropertyEmojiModifier:        "Emoji_Modifier",
+	PropertyEmojiModifierBase:    "Emoji_Modifier_Base",
+	PropertyEmojiComponent:       "Emoji_Component",
+	PropertyExtendedPictographic: "Extended_Pictographic",
+}
+
+// String returns the name of the property in emoji-data.txt, such as
+// "Emoji_Presentation".
+func (p Property) String() string {
+	if p < 0 || int(p) >= len(propertyNames) {
+		return "Property(" + strconv.Itoa(int(p)) + ")"
+	}
+	return propertyNames[p]
+}
+
+// HasProperty reports whether r has the emoji property prop.
+func HasProperty(r rune, prop Property) bool {
+	if prop < 0 || int(prop) >= len(propertyNames) {
+		return false
+	}
+	return unicode.Is(emojiPropertyTables[propertyNames[prop]], r)
+}
+
+// IsEmoji reports whether r has the Emoji property. Note that it is set for "#", "*"
+// and the digits, and for characters like "©" that are shown as text by default; see
+// PropertyEmojiPresentation.
+func IsEmoji(r rune) bool {
+	return HasProperty(r, PropertyEmoji)
+}
+
+var rgiEmojiSequences map[string]bool
+var rgiEmojiSequencesInitOnce = sync.Once{}
+
+// IsRGI reports whether seq is one of the emoji sequences recommended for general
+// interchange (RGI), which are the emoji that vendors support. The sequence must be
+// fully qualified: "\u2764\ufe0f" is the RGI red heart, "\u2764" is not.
+func IsRGI(seq string) bool {
+	rgiEmojiSequencesInitOnce.Do(func() {
+		rgiEmojiSequences = make(map[string]bool, len(rgiEmojiSequenceList))
+		for _, s := range rgiEmojiSequenceList {
+			rgiEmojiSequences[s] = true
+		}
+	})
+	return rgiEmojiSequences[seq]
+}
@@ -0,0 +1,65 @@
+package emoji
+
+import "testing"
+
+func TestHasProperty(t *testing.T) {
+	tests := []struct {
+		r        rune
+		prop     Property
+		expected bool
+	}{
+		{'\U0001f37a', PropertyEmoji, true},
+		{'\U0001f37a', PropertyEmojiPresentation, true},
+		{'©', PropertyEmoji, true},
+		{'©', PropertyEmojiPresentation, false},
+		{'#', PropertyEmoji, true},
+		{'#', PropertyEmojiComponent, true},
+		{'a', PropertyEmoji, false},
+		{'\U0001f3fd', PropertyEmojiModifier, true},
+		{'\U0001f44b', PropertyEmojiModifierBase, true},
+		{'\U0001f37a', PropertyEmojiModifierBase, false},
+		{'\u200d', PropertyEmojiComponent, true},
+		{'\U000e0067', PropertyEmojiComponent, true},
+		{'\U0001fa00', PropertyExtendedPictographic, true},
+		{'\U0001fa00', PropertyEmoji, false},
+		{'\U0001f37a', Property(-1), false},
+		{'\U0001f37a', Property(100), false},
+	}
+	for _, tt := range tests {
+		if actual := HasProperty(tt.r, tt.prop); actual != tt.expected {
+			t.Errorf("HasProperty(%U, %v) = %v, expected %v", tt.r, tt.prop, actual, tt.expected)
+		}
+	}
+	if !IsEmoji('\U0001f680') || IsEmoji('x') {
+		t.Errorf("IsEmoji")
+	}
+	if PropertyExtendedPictographic.String() != "Extended_Pictographic" || Property(9).String() != "Property(9)" {
+		t.Errorf("String %q %q", PropertyExtendedPictographic, Property(9))
+	}
+}
+
+func TestIsRGI(t *testing.T) {
+	tests := []struct {
+		seq      string
+		expected bool
+	}{
+		{"\U0001f37a", true},
+		{"\u2764\ufe0f", true},
+		{"\u2764", false},
+		{"#\ufe0f\u20e3", true},
+		{"\U0001f44b\U0001f3fd", true},
+		{"\U0001f468\u200d\U0001f469\u200d\U0001f467", true},
+		{"\U0001f1ef\U0001f1f5", true},
+		{"\U0001f1ef\U0001f1ef", false},
+		{"\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", true},
+		{"\U0001f37a\U0001f37a", false},
+		{"\U0001f3fd", false},
+		{"a", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if actual := IsRGI(tt.seq); actual != tt.expected {
+			t.Errorf("IsRGI(%+q) = %v, expected %v", tt.seq, actual, tt.expected)
+		}
+	}
+}
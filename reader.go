@@ -0,0 +1,60 @@
+package emoji
+
+import (
+	"io"
+
+	"golang.org/x/text/transform"
+)
+
+// Transformer replaces shortcodes in the style of golang.org/x/text/transform, so it
+// can be used with transform.NewReader, transform.Chain and friends. The zero value
+// is ready to use.
+type Transformer struct {
+	s   scanner
+	out []byte
+}
+
+var _ transform.Transformer = (*Transformer)(nil)
+
+// NewTransformer returns a Transformer that emojizes its input.
+func NewTransformer() *Transformer {
+	return defaultReplacer.NewTransformer()
+}
+
+// Transform implements transform.Transformer.
+func (t *Transformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	if t.s.r == nil {
+		t.s.r = defaultReplacer
+	}
+	if len(t.out) > 0 {
+		nDst = copy(dst, t.out)
+		t.out = t.out[:copy(t.out, t.out[nDst:])]
+		if len(t.out) > 0 {
+			return nDst, 0, transform.ErrShortDst
+		}
+	}
+
+	t.out, nSrc = t.s.scan(t.out, src, atEOF)
+	n := copy(dst[nDst:], t.out)
+	nDst += n
+	t.out = t.out[:copy(t.out, t.out[n:])]
+	switch {
+	case len(t.out) > 0:
+		return nDst, nSrc, transform.ErrShortDst
+	case nSrc < len(src):
+		return nDst, nSrc, transform.ErrShortSrc
+	}
+	return nDst, nSrc, nil
+}
+
+// Reset implements transform.Transformer.
+func (t *Transformer) Reset() {
+	t.s = scanner{r: t.s.r}
+	t.out = t.out[:0]
+}
+
+// NewReader returns a reader that emojizes what it reads from r. The output is the
+// same as Sprint over the whole input.
+func NewReader(r io.Reader) io.Reader {
+	return defaultReplacer.NewReader(r)
+}
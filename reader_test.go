@@ -0,0 +1,68 @@
+package emoji
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"golang.org/x/text/transform"
+)
+
+func TestReader(t *testing.T) {
+	inputs := []string{
+		beerKey + beerText,
+		"A :smile: and another: :smile:",
+		"::smile: :flag-us: :unknown: :+1:",
+		"ends inside :bee",
+		strings.Repeat(":+1:", 5000) + " and " + strings.Repeat(":"+strings.Repeat("x", 100), 50),
+	}
+	for _, in := range inputs {
+		expected := Sprint(in)
+		b, err := io.ReadAll(NewReader(iotest.OneByteReader(strings.NewReader(in))))
+		if err != nil {
+			t.Fatal("ReadAll ", err)
+		}
+		if string(b) != expected {
+			t.Errorf("NewReader %q != %q", b, expected)
+		}
+	}
+}
+
+func TestTransformer(t *testing.T) {
+	in := "A :smile: and a :beer: day keeps the doctor away"
+	tr := NewTransformer()
+	out, n, err := transform.String(tr, in)
+	if err != nil {
+		t.Fatal("transform.String ", err)
+	}
+	if n != len(in) {
+		t.Errorf("transform.String consumed %d bytes, expected %d", n, len(in))
+	}
+	if out != Sprint(in) {
+		t.Errorf("transform.String %q != %q", out, Sprint(in))
+	}
+
+	dst := make([]byte, 64)
+	nDst, nSrc, err := tr.Transform(dst, []byte("some :bee"), false)
+	if err != transform.ErrShortSrc {
+		t.Errorf("Transform err %v, expected %v", err, transform.ErrShortSrc)
+	}
+	if string(dst[:nDst]) != "some " || nSrc != len("some ") {
+		t.Errorf("Transform %q, %d", dst[:nDst], nSrc)
+	}
+
+	tr.Reset()
+	nDst, _, err = tr.Transform(dst[:3], []byte(":beer::beer:"), true)
+	if err != transform.ErrShortDst {
+		t.Errorf("Transform err %v, expected %v", err, transform.ErrShortDst)
+	}
+	rest := make([]byte, 64)
+	nRest, _, err := tr.Transform(rest, nil, true)
+	if err != nil {
+		t.Error("Transform ", err)
+	}
+	if actual := string(dst[:nDst]) + string(rest[:nRest]); actual != Sprint(":beer::beer:") {
+		t.Errorf("Transform %q != %q", actual, Sprint(":beer::beer:"))
+	}
+}
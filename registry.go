@@ -0,0 +1,195 @@
+package emoji
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInvalidShortCode is returned when registering a shortcode that the printing
+// functions could never match.
+var ErrInvalidShortCode = errors.New("emoji: invalid shortcode")
+
+// codeTables holds a consistent view of the built-in and registered shortcodes.
+type codeTables struct {
+	codeMap    map[string]string
+	revCodeMap map[string][]string
+	maxLen     int
+
+	// images are the image emoji, and imageAliases the shortcodes of each image URL.
+	images       map[string]ImageEmoji
+	imageAliases map[string][]string
+
+	trie         *emojiTrie
+	trieInitOnce sync.Once
+
+	index         []*searchEntry
+	indexInitOnce sync.Once
+}
+
+func (t *codeTables) sequenceTrie() *emojiTrie {
+	t.trieInitOnce.Do(func() {
+		t.trie = newEmojiTrie(t.revCodeMap)
+	})
+	return t.trie
+}
+
+func (t *codeTables) searchIndex() []*searchEntry {
+	t.indexInitOnce.Do(func() {
+		t.index = newSearchIndex(t.revCodeMap)
+	})
+	return t.index
+}
+
+// newCodeTables merges registered shortcodes into the built-in ones. Registered
+// shortcodes take precedence, and come after the built-in aliases of the same emoji.
+func newCodeTables(registered map[string]string, images map[string]ImageEmoji) *codeTables {
+	if len(registered) == 0 && len(images) == 0 {
+		return &codeTables{
+			codeMap:    emojiCode(),
+			revCodeMap: emojiRevCode(),
+			maxLen:     shortCodeMaxLen(),
+		}
+	}
+
+	codeMap := make(map[string]string, len(emojiCode())+len(registered))
+	for shortCode, code := range emojiCode() {
+		codeMap[shortCode] = code
+	}
+	revCodeMap := make(map[string][]string, len(emojiRevCode())+len(registered))
+	for code, shortCodes := range emojiRevCode() {
+		revCodeMap[code] = shortCodes
+	}
+
+	shortCodes := make([]string, 0, len(registered)+len(images))
+	for shortCode := range registered {
+		shortCodes = append(shortCodes, shortCode)
+	}
+	for shortCode := range images {
+		shortCodes = append(shortCodes, shortCode)
+	}
+	sort.Strings(shortCodes)
+	for _, shortCode := range shortCodes {
+		if old, ok := codeMap[shortCode]; ok {
+			revCodeMap[old] = removeShortCode(revCodeMap[old], shortCode)
+			if len(revCodeMap[old]) == 0 {
+				delete(revCodeMap, old)
+			}
+			delete(codeMap, shortCode)
+		}
+	}
+
+	t := &codeTables{
+		images:       images,
+		imageAliases: make(map[string][]string),
+	}
+	for _, shortCode := range shortCodes {
+		if img, ok := images[shortCode]; ok {
+			t.imageAliases[img.URL] = append(t.imageAliases[img.URL], shortCode)
+			continue
+		}
+		code := registered[shortCode]
+		codeMap[shortCode] = code
+		aliases := make([]string, len(revCodeMap[code]), len(revCodeMap[code])+1)
+		copy(aliases, revCodeMap[code])
+		revCodeMap[code] = append(aliases, shortCode)
+	}
+
+	t.codeMap, t.revCodeMap = codeMap, revCodeMap
+	t.maxLen = codeMapMaxLen(codeMap)
+	for shortCode := range images {
+		if len(shortCode) > t.maxLen {
+			t.maxLen = len(shortCode)
+		}
+	}
+	return t
+}
+
+// removeShortCode returns a copy of shortCodes without shortCode.
+func removeShortCode(shortCodes []string, shortCode string) []string {
+	removed := make([]string, 0, len(shortCodes))
+	for _, s := range shortCodes {
+		if s != shortCode {
+			removed = append(removed, s)
+		}
+	}
+	return removed
+}
+
+var registry = struct {
+	mu     sync.Mutex
+	codes  map[string]string
+	images map[string]ImageEmoji
+	tables atomic.Pointer[codeTables]
+}{
+	codes:  make(map[string]string),
+	images: make(map[string]ImageEmoji),
+}
+
+// currentTables returns the shortcode tables including the registered shortcodes.
+// The result must not be modified; it is replaced when the registry changes.
+func currentTables() *codeTables {
+	if t := registry.tables.Load(); t != nil {
+		return t
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	if t := registry.tables.Load(); t != nil {
+		return t
+	}
+	t := newCodeTables(registry.codes, registry.images)
+	registry.tables.Store(t)
+	return t
+}
+
+// validShortCode reports whether shortCode is a colon-delimited name that the
+// printing functions can match: no whitespace, colons or backslashes inside.
+func validShortCode(shortCode string) bool {
+	if len(shortCode) < 3 || shortCode[0] != ':' || shortCode[len(shortCode)-1] != ':' || !utf8.ValidString(shortCode) {
+		return false
+	}
+	return !strings.ContainsFunc(shortCode[1:len(shortCode)-1], func(r rune) bool {
+		return r == ':' || r == '\\' || unicode.IsSpace(r)
+	})
+}
+
+// Register adds a custom shortcode, such as ":shipit:", that the printing functions
+// and every Replacer without its own code map replace with value. Registered shortcodes
+// take precedence over the built-in ones, and show up in CodeMap, RevCodeMap and
+// AliasList. Register is safe for concurrent use.
+func Register(shortCode, value string) error {
+	if !validShortCode(shortCode) {
+		return fmt.Errorf("%w: %q", ErrInvalidShortCode, shortCode)
+	}
+	if value == "" || !utf8.ValidString(value) {
+		return fmt.Errorf("emoji: invalid value %q for %s", value, shortCode)
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	registry.codes[shortCode] = value
+	delete(registry.images, shortCode)
+	registry.tables.Store(nil)
+	return nil
+}
+
+// Unregister removes a shortcode added by Register or RegisterImage. Built-in
+// shortcodes it overrode are restored.
+func Unregister(shortCode string) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	_, isCode := registry.codes[shortCode]
+	_, isImage := registry.images[shortCode]
+	if !isCode && !isImage {
+		return
+	}
+	delete(registry.codes, shortCode)
+	delete(registry.images, shortCode)
+	registry.tables.Store(nil)
+}
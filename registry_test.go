@@ -0,0 +1,86 @@
+package emoji
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestRegister(t *testing.T) {
+	longCode := ":" + strings.Repeat("long", 20) + ":"
+	for shortCode, value := range map[string]string{":shipit:": "\U0001f680", ":beer:": "\U0001f37b", longCode: "\U0001f40d"} {
+		if err := Register(shortCode, value); err != nil {
+			t.Fatal("Register ", err)
+		}
+		defer Unregister(shortCode)
+	}
+
+	if actual := Sprint(":shipit: :beer:"); actual != "\U0001f680  \U0001f37b " {
+		t.Errorf("Sprint %q", actual)
+	}
+	if actual := Sprint(longCode); actual != "\U0001f40d " {
+		t.Errorf("Sprint %q", actual)
+	}
+	if CodeMap()[":shipit:"] != "\U0001f680" {
+		t.Error("CodeMap doesn't have :shipit:")
+	}
+	if aliases := AliasList(":shipit:"); aliases[0] != ":rocket:" || aliases[len(aliases)-1] != ":shipit:" {
+		t.Errorf("AliasList(:shipit:) = %q", aliases)
+	}
+	for _, shortCode := range RevCodeMap()["\U0001f37a"] {
+		if shortCode == ":beer:" {
+			t.Error("RevCodeMap still has :beer: for the overridden emoji")
+		}
+	}
+	if actual := Demojize("\U0001f40d"); actual != NormalizeShortCode(longCode) {
+		t.Errorf("Demojize %q", actual)
+	}
+	if actual := NewReplacer(WithCodeMap(map[string]string{})).Sprint(":shipit:"); actual != ":shipit:" {
+		t.Errorf("Replacer with its own code map Sprint %q", actual)
+	}
+
+	Unregister(":beer:")
+	if actual := Sprint(":beer:"); actual != Emojize(beerKey) || actual != "\U0001f37a " {
+		t.Errorf("Sprint after Unregister %q", actual)
+	}
+	if aliases := AliasList(":beer:"); aliases[0] != ":beer:" {
+		t.Errorf("AliasList after Unregister %q", aliases)
+	}
+}
+
+func TestRegisterInvalid(t *testing.T) {
+	for _, shortCode := range []string{"", "::", "shipit", ":ship it:", ":ship:it:", `:ship\it:`, ":ship"} {
+		if err := Register(shortCode, "\U0001f680"); !errors.Is(err, ErrInvalidShortCode) {
+			t.Errorf("Register(%q) error %v", shortCode, err)
+		}
+	}
+	if err := Register(":shipit:", ""); err == nil {
+		t.Error("Register with empty value expected error")
+	}
+}
+
+func TestRegisterConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if err := Register(":oncall:", "\U0001f4df"); err != nil {
+					t.Error("Register ", err)
+				}
+				Unregister(":oncall:")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if actual := Sprint(":oncall: :beer:"); !strings.HasSuffix(actual, "\U0001f37a ") {
+					t.Errorf("Sprint %q", actual)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
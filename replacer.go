@@ -0,0 +1,234 @@
+package emoji
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+
+	"golang.org/x/text/transform"
+)
+
+// Replacer replaces shortcodes with emoji. Unlike the package-level functions, which
+// use the global ReplacePadding, a Replacer carries its own settings, so several
+// Replacers with different settings can be used concurrently.
+type Replacer struct {
+	padding PaddingPolicy
+	codeMap map[string]string
+	maxLen  int
+
+	htmlTemplate      *template.Template
+	htmlImageTemplate *template.Template
+	htmlClass         string
+	imagePlaceholder  *string
+
+	// noContextRules replaces shortcodes regardless of the text around them.
+	noContextRules bool
+
+	// maxVersion is the newest emoji version that is replaced, if set.
+	maxVersion    *emojiVersion
+	newerFallback *string
+
+	// collapseSpace makes Strip collapse the whitespace left by removed emoji.
+	collapseSpace bool
+}
+
+// Option configures a Replacer.
+type Option func(*Replacer)
+
+// WithPadding sets the string written after every emoji. The default is ReplacePadding
+// at the time NewReplacer is called.
+func WithPadding(padding string) Option {
+	return WithPaddingPolicy(FixedPadding(padding))
+}
+
+// WithPaddingPolicy sets the policy that decides the padding after each emoji.
+func WithPaddingPolicy(policy PaddingPolicy) Option {
+	return func(r *Replacer) {
+		r.padding = policy
+	}
+}
+
+// WithCodeMap replaces the built-in shortcode to emoji map, and the shortcodes added
+// by Register. Shortcodes include their colons, as in CodeMap.
+func WithCodeMap(codeMap map[string]string) Option {
+	return func(r *Replacer) {
+		r.codeMap = make(map[string]string, len(codeMap))
+		for shortCode, code := range codeMap {
+			r.codeMap[shortCode] = code
+		}
+	}
+}
+
+// WithContextRules sets whether shortcodes are only replaced when they stand apart
+// from the text around them, which is the default. A shortcode then must not be
+// preceded or followed by an ASCII letter or digit, so "12:30:45" and "a:b:c" are left
+// alone, and URLs and IPv6 addresses, such as "http://host:8080/a:b:" and "fe80::1",
+// are never changed. WithContextRules(false) replaces shortcodes anywhere, as earlier
+// versions of this package did.
+func WithContextRules(enabled bool) Option {
+	return func(r *Replacer) {
+		r.noContextRules = !enabled
+	}
+}
+
+// NewReplacer returns a Replacer configured by opts.
+func NewReplacer(opts ...Option) *Replacer {
+	r := &Replacer{padding: FixedPadding(ReplacePadding)}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.codeMap != nil {
+		r.maxLen = codeMapMaxLen(r.codeMap)
+	}
+	return r
+}
+
+// defaultReplacer backs the package-level functions. It reads ReplacePadding on every
+// replacement and uses the built-in code map.
+var defaultReplacer = &Replacer{padding: packagePadding{}}
+
+func (r *Replacer) codes() map[string]string {
+	if r.codeMap == nil {
+		return currentTables().codeMap
+	}
+	return r.codeMap
+}
+
+func (r *Replacer) contextRules() bool {
+	return !r.noContextRules
+}
+
+// images returns the image emoji, which are only available without a custom code map.
+func (r *Replacer) images() map[string]ImageEmoji {
+	if r.codeMap == nil {
+		return currentTables().images
+	}
+	return nil
+}
+
+func (r *Replacer) shortCodeMaxLen() int {
+	if r.codeMap == nil {
+		return currentTables().maxLen
+	}
+	return r.maxLen
+}
+
+// Emojize converts a single shortcode to its emoji. For unsupported emoji, the string
+// passed as an argument is returned as is.
+func (r *Replacer) Emojize(x string) string {
+	return r.emojize(x, EndOfInput)
+}
+
+// emojize converts a shortcode followed by next in the input.
+func (r *Replacer) emojize(x string, next rune) string {
+	code, padded, ok := r.lookup(x)
+	switch {
+	case !ok:
+		return x
+	case padded:
+		return code + r.padding.Padding(code, next)
+	}
+	return code
+}
+
+// lookup returns the emoji for a shortcode, and whether it is padded. Flags from the
+// flag shortcode grammar are not, and neither is the text image emoji are replaced by.
+func (r *Replacer) lookup(shortCode string) (string, bool, bool) {
+	code, ok := r.codes()[shortCode]
+	if !ok {
+		if base, tone := splitToneShortCode(shortCode); tone != NoSkinTone {
+			code, ok = withSkinTone(r.codes()[base], tone)
+		}
+	}
+	if ok {
+		if r.tooNew(code) {
+			return r.newerEmoji()
+		}
+		return code, true, true
+	}
+	if _, ok := r.images()[shortCode]; ok {
+		if r.imagePlaceholder != nil {
+			return *r.imagePlaceholder, false, true
+		}
+		return shortCode, false, true
+	}
+	if flag, ok := flagFromShortCode(shortCode); ok {
+		if r.tooNew(flag) {
+			return r.newerEmoji()
+		}
+		return flag, false, true
+	}
+	return "", false, false
+}
+
+// Replace replaces every shortcode in s.
+func (r *Replacer) Replace(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	sc := scanner{r: r}
+	output, _ := sc.scan(make([]byte, 0, len(s)), []byte(s), true)
+	return string(output)
+}
+
+// Print is fmt.Print which supports emoji
+func (r *Replacer) Print(a ...interface{}) (int, error) {
+	return fmt.Print(r.Replace(fmt.Sprint(a...)))
+}
+
+// Println is fmt.Println which supports emoji
+func (r *Replacer) Println(a ...interface{}) (int, error) {
+	return fmt.Println(r.Replace(fmt.Sprint(a...)))
+}
+
+// Printf is fmt.Printf which supports emoji
+func (r *Replacer) Printf(format string, a ...interface{}) (int, error) {
+	return fmt.Print(r.Replace(fmt.Sprintf(format, a...)))
+}
+
+// Fprint is fmt.Fprint which supports emoji
+func (r *Replacer) Fprint(w io.Writer, a ...interface{}) (int, error) {
+	return fmt.Fprint(w, r.Replace(fmt.Sprint(a...)))
+}
+
+// Fprintln is fmt.Fprintln which supports emoji
+func (r *Replacer) Fprintln(w io.Writer, a ...interface{}) (int, error) {
+	return fmt.Fprintln(w, r.Replace(fmt.Sprint(a...)))
+}
+
+// Fprintf is fmt.Fprintf which supports emoji
+func (r *Replacer) Fprintf(w io.Writer, format string, a ...interface{}) (int, error) {
+	return fmt.Fprint(w, r.Replace(fmt.Sprintf(format, a...)))
+}
+
+// Sprint is fmt.Sprint which supports emoji
+func (r *Replacer) Sprint(a ...interface{}) string {
+	return r.Replace(fmt.Sprint(a...))
+}
+
+// Sprintf is fmt.Sprintf which supports emoji
+func (r *Replacer) Sprintf(format string, a ...interface{}) string {
+	return r.Replace(fmt.Sprintf(format, a...))
+}
+
+// Errorf is fmt.Errorf which supports emoji
+func (r *Replacer) Errorf(format string, a ...interface{}) error {
+	return errors.New(r.Sprintf(format, a...))
+}
+
+// NewWriter is NewWriter using the settings of r.
+func (r *Replacer) NewWriter(w io.Writer) io.WriteCloser {
+	return &writer{w: w, s: scanner{r: r}}
+}
+
+// NewReader is NewReader using the settings of r.
+func (r *Replacer) NewReader(rd io.Reader) io.Reader {
+	return transform.NewReader(rd, r.NewTransformer())
+}
+
+// NewTransformer is NewTransformer using the settings of r.
+func (r *Replacer) NewTransformer() *Transformer {
+	return &Transformer{s: scanner{r: r}}
+}
@@ -0,0 +1,107 @@
+package emoji
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestReplacerPadding(t *testing.T) {
+	r := NewReplacer(WithPadding(""))
+	if actual := r.Sprint(beerKey + beerText); actual != "\U0001f37a"+beerText {
+		t.Error("Sprint ", actual)
+	}
+	if actual := Sprint(beerKey + beerText); actual != testText {
+		t.Error("Sprint ", actual, testText)
+	}
+}
+
+func TestReplacerCodeMap(t *testing.T) {
+	r := NewReplacer(WithCodeMap(map[string]string{":shipit:": "\U0001f680"}))
+	if actual := r.Sprint(":shipit: :beer:"); actual != "\U0001f680  :beer:" {
+		t.Errorf("Sprint %q", actual)
+	}
+	if actual := r.Emojize(flag); actual != Emojize(flag) {
+		t.Errorf("Emojize %q != %q", actual, Emojize(flag))
+	}
+
+	long := ":" + strings.Repeat("a", 40) + ":"
+	r = NewReplacer(WithCodeMap(map[string]string{long: "\U0001f680"}))
+	var buf bytes.Buffer
+	w := r.NewWriter(&buf)
+	for i := 0; i < len(long); i++ {
+		if _, err := w.Write([]byte{long[i]}); err != nil {
+			t.Fatal("Write ", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal("Close ", err)
+	}
+	if buf.String() != "\U0001f680 " {
+		t.Errorf("Writer %q", buf.String())
+	}
+}
+
+func TestReplacerFprintf(t *testing.T) {
+	r := NewReplacer(WithPadding("_"))
+	var buf bytes.Buffer
+	if _, err := r.Fprintf(&buf, "%s "+beerKey, "test"); err != nil {
+		t.Error("Fprintf ", err)
+	}
+	if buf.String() != "test \U0001f37a_" {
+		t.Errorf("Fprintf %q", buf.String())
+	}
+	if err := r.Errorf("%s "+beerKey, "test"); err.Error() != "test \U0001f37a_" {
+		t.Errorf("Errorf %q", err)
+	}
+}
+
+func TestReplacerConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for _, padding := range []string{"", " ", "  "} {
+		r := NewReplacer(WithPadding(padding))
+		expected := "\U0001f37a" + padding + beerText
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				if actual := r.Sprint(beerKey + beerText); actual != expected {
+					t.Errorf("Sprint %q != %q", actual, expected)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestContextRules(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"12:30:45", "12:30:45"},
+		{"at 10:100:", "at 10:100:"},
+		{"a:beer:", "a:beer:"},
+		{":beer:a", ":beer:a"},
+		{":beer:ok:beer:", ":beer:ok:beer:"},
+		{"(:beer:)", "(\U0001f37a)"},
+		{":beer::+1:", "\U0001f37a\U0001f44d"},
+		{"http://host:8080/a:b: :beer:", "http://host:8080/a:b: \U0001f37a"},
+		{"https://example.com/:100:", "https://example.com/:100:"},
+		{"fe80::100:", "fe80::100:"},
+		{"std::vector :100:", "std::vector \U0001f4af"},
+		{"ビール:beer:", "ビール\U0001f37a"},
+	}
+	r := NewReplacer(WithPadding(""))
+	compat := NewReplacer(WithPadding(""), WithContextRules(false))
+	for _, tt := range tests {
+		if actual := r.Replace(tt.in); actual != tt.expected {
+			t.Errorf("Replace(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+	if actual := compat.Replace("a:beer: 12:100:"); actual != "a\U0001f37a 12\U0001f4af" {
+		t.Errorf("Replace without context rules %q", actual)
+	}
+}
@@ -0,0 +1,148 @@
+package emoji
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// searchEntry is an emoji with the words it can be found by.
+type searchEntry struct {
+	char     string
+	name     string
+	aliases  []string
+	keywords []string
+	order    int
+
+	nameWords, aliasWords, keywordWords []string
+}
+
+// newSearchIndex returns the emoji that have metadata or shortcodes, in the order
+// of emoji-test.txt followed by the emoji only known by their shortcodes.
+func newSearchIndex(revCodeMap map[string][]string) []*searchEntry {
+	byKey := make(map[string]*searchEntry)
+	var index []*searchEntry
+	for _, d := range emojiDataTable() {
+		if hasSkinTone(d.char) {
+			// found through the emoji without a skin tone
+			continue
+		}
+		e := &searchEntry{char: d.char, name: d.name, keywords: d.keywords, order: len(index)}
+		byKey[dataKey(d.char)] = e
+		index = append(index, e)
+	}
+
+	codes := make([]string, 0, len(revCodeMap))
+	for code := range revCodeMap {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		e, ok := byKey[dataKey(code)]
+		if !ok && hasSkinTone(code) {
+			continue
+		}
+		if !ok {
+			e = &searchEntry{char: code, order: len(index)}
+			byKey[dataKey(code)] = e
+			index = append(index, e)
+		}
+		for _, shortCode := range revCodeMap[code] {
+			e.aliases = append(e.aliases, strings.ToLower(strings.Trim(shortCode, ":")))
+		}
+	}
+
+	for _, e := range index {
+		e.nameWords = searchWords(e.name)
+		for _, alias := range e.aliases {
+			e.aliasWords = append(e.aliasWords, searchWords(alias)...)
+		}
+		for _, keyword := range e.keywords {
+			e.keywordWords = append(e.keywordWords, searchWords(keyword)...)
+		}
+	}
+	return index
+}
+
+// searchWords splits text into lowercase words.
+func searchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Search returns the emoji that match query, best match first. Every word of query
+// must match a word of the name, a shortcode or a keyword of an emoji, or be the
+// beginning of one. Exact matches of a shortcode or name rank first, then matches of
+// whole words, in the name before the shortcodes before the keywords:
+//
+//	Search("beer") → ["🍺", "🍻"]
+func Search(query string) []string {
+	words := searchWords(query)
+	if len(words) == 0 {
+		return nil
+	}
+	phrase := strings.Join(words, " ")
+	shortCode := strings.ReplaceAll(strings.Trim(strings.ToLower(strings.TrimSpace(query)), ":"), " ", "_")
+
+	type match struct {
+		e     *searchEntry
+		score int
+	}
+	var matches []match
+	for _, e := range currentTables().searchIndex() {
+		score := 0
+		for _, word := range words {
+			s := max(
+				wordScore(word, e.nameWords, 10, 6),
+				wordScore(word, e.aliasWords, 8, 5),
+				wordScore(word, e.keywordWords, 7, 4),
+			)
+			if s == 0 {
+				score = 0
+				break
+			}
+			score += s
+		}
+		if score == 0 {
+			continue
+		}
+		if strings.ToLower(e.name) == phrase {
+			score += 100
+		}
+		for _, alias := range e.aliases {
+			if alias == shortCode {
+				score += 100
+				break
+			}
+		}
+		matches = append(matches, match{e, score})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return matches[i].e.order < matches[j].e.order
+	})
+	chars := make([]string, len(matches))
+	for i, m := range matches {
+		chars[i] = m.e.char
+	}
+	return chars
+}
+
+// wordScore returns whole if word is one of words, prefix if it begins one of them,
+// and 0 otherwise.
+func wordScore(word string, words []string, whole, prefix int) int {
+	score := 0
+	for _, w := range words {
+		if w == word {
+			return whole
+		}
+		if strings.HasPrefix(w, word) {
+			score = prefix
+		}
+	}
+	return score
+}
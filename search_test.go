@@ -0,0 +1,52 @@
+package emoji
+
+import "testing"
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		query string
+		first []string
+	}{
+		{"beer", []string{"\U0001f37a", "\U0001f37b"}},
+		{"BEER MUG", []string{"\U0001f37a"}},
+		{":+1:", []string{"\U0001f44d"}},
+		{"thumbs up", []string{"\U0001f44d"}},
+		{"piz", []string{"\U0001f355"}},
+		{"drink", []string{"\U0001f379"}},
+	}
+	for _, tt := range tests {
+		actual := Search(tt.query)
+		if len(actual) < len(tt.first) {
+			t.Errorf("Search(%q) = %q, expected %q first", tt.query, actual, tt.first)
+			continue
+		}
+		for i, char := range tt.first {
+			if actual[i] != char {
+				t.Errorf("Search(%q) = %q, expected %q first", tt.query, actual, tt.first)
+				break
+			}
+		}
+	}
+
+	for _, query := range []string{"", "  ", "xyzzyq", "beer xyzzyq"} {
+		if actual := Search(query); len(actual) != 0 {
+			t.Errorf("Search(%q) = %q", query, actual)
+		}
+	}
+	for _, char := range Search("thumbs") {
+		if hasSkinTone(char) {
+			t.Errorf("Search(thumbs) has %q", char)
+		}
+	}
+}
+
+func TestSearchRegistered(t *testing.T) {
+	if err := Register(":shipit:", "\U0001f43f\ufe0f"); err != nil {
+		t.Fatal("Register ", err)
+	}
+	defer Unregister(":shipit:")
+
+	if actual := Search("shipit"); len(actual) == 0 || actual[0] != "\U0001f43f\ufe0f" {
+		t.Errorf("Search(shipit) = %q", actual)
+	}
+}
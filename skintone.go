@@ -0,0 +1,140 @@
+package emoji
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SkinTone is one of the Fitzpatrick skin tone modifiers.
+type SkinTone int
+
+// Skin tones, numbered like the "_tone1" to "_tone5" shortcode suffixes. The Slack
+// shortcodes ":skin-tone-2:" to ":skin-tone-6:" start at SkinToneLight.
+const (
+	NoSkinTone SkinTone = iota
+	SkinToneLight
+	SkinToneMediumLight
+	SkinToneMedium
+	SkinToneMediumDark
+	SkinToneDark
+)
+
+// firstSkinToneModifier is the modifier of SkinToneLight, U+1F3FB.
+const firstSkinToneModifier = '\U0001F3FB'
+
+// Modifier returns the modifier character of t, or "" for NoSkinTone.
+func (t SkinTone) Modifier() string {
+	if t < SkinToneLight || t > SkinToneDark {
+		return ""
+	}
+	return string(firstSkinToneModifier + rune(t-SkinToneLight))
+}
+
+// skinToneOf returns the skin tone of a modifier character.
+func skinToneOf(r rune) SkinTone {
+	if r < firstSkinToneModifier || r > firstSkinToneModifier+rune(SkinToneDark-SkinToneLight) {
+		return NoSkinTone
+	}
+	return SkinToneLight + SkinTone(r-firstSkinToneModifier)
+}
+
+// emojiModifierBase is the Emoji_Modifier_Base property from Unicode's emoji-data.txt.
+var emojiModifierBase = emojiPropertyTables["Emoji_Modifier_Base"]
+
+// takesSkinTone reports whether a skin tone can be applied to the emoji sequence:
+// its first character is a modifier base and it contains no other modifier base or
+// modifier, which rules out sequences of several people and emoji that have a tone.
+func takesSkinTone(code string) bool {
+	for i, r := range code {
+		isBase := unicode.Is(emojiModifierBase, r)
+		if isBase != (i == 0) || skinToneOf(r) != NoSkinTone {
+			return false
+		}
+	}
+	return code != ""
+}
+
+// hasSkinTone reports whether an emoji sequence has a skin tone, which is the case
+// when a modifier follows another character.
+func hasSkinTone(code string) bool {
+	for i, r := range code {
+		if i > 0 && skinToneOf(r) != NoSkinTone {
+			return true
+		}
+	}
+	return false
+}
+
+// withSkinTone applies tone to an emoji sequence. The modifier follows the first
+// character and replaces its variation selector, if any.
+func withSkinTone(code string, tone SkinTone) (string, bool) {
+	if tone == NoSkinTone || !takesSkinTone(code) {
+		return "", false
+	}
+	_, n := utf8.DecodeRuneInString(code)
+	rest := strings.TrimPrefix(code[n:], string(variationSelector16))
+	return code[:n] + tone.Modifier() + rest, true
+}
+
+// SplitSkinTone splits an emoji sequence into the sequence without its skin tone and
+// the tone. Sequences without a skin tone are returned as is, with NoSkinTone.
+func SplitSkinTone(code string) (string, SkinTone) {
+	r, n := utf8.DecodeRuneInString(code)
+	if !unicode.Is(emojiModifierBase, r) {
+		return code, NoSkinTone
+	}
+	modifier, size := utf8.DecodeRuneInString(code[n:])
+	tone := skinToneOf(modifier)
+	if tone == NoSkinTone {
+		return code, NoSkinTone
+	}
+	return code[:n] + code[n+size:], tone
+}
+
+// splitToneShortCode splits a shortcode such as ":+1_tone3:" into ":+1:" and its tone.
+func splitToneShortCode(shortCode string) (string, SkinTone) {
+	const suffixLen = len("_toneN:")
+	if len(shortCode) <= suffixLen+1 || !strings.HasSuffix(shortCode, ":") {
+		return shortCode, NoSkinTone
+	}
+	suffix := shortCode[len(shortCode)-suffixLen:]
+	tone := SkinTone(suffix[len("_tone")] - '0')
+	if !strings.HasPrefix(suffix, "_tone") || tone < SkinToneLight || tone > SkinToneDark {
+		return shortCode, NoSkinTone
+	}
+	return shortCode[:len(shortCode)-suffixLen] + ":", tone
+}
+
+// toneShortCode returns the shortcode for the emoji of shortCode with a skin tone,
+// as in ":+1_tone3:".
+func toneShortCode(shortCode string, tone SkinTone) string {
+	return strings.TrimSuffix(shortCode, ":") + "_tone" + strconv.Itoa(int(tone)) + ":"
+}
+
+// slackSkinTone returns the Slack shortcode of a skin tone, as in ":skin-tone-4:".
+func slackSkinTone(tone SkinTone) string {
+	return ":skin-tone-" + strconv.Itoa(int(tone)+1) + ":"
+}
+
+// scanSkinTone reads a Slack skin tone shortcode such as ":skin-tone-4:" from the
+// start of src. It returns false if more input is needed to decide.
+func scanSkinTone(src []byte, atEOF bool) (SkinTone, int, bool) {
+	const prefix = ":skin-tone-"
+	size := len(prefix) + 2
+	if len(src) < size {
+		if !atEOF && strings.HasPrefix(prefix, string(src[:min(len(src), len(prefix))])) {
+			return NoSkinTone, 0, false
+		}
+		return NoSkinTone, 0, true
+	}
+	if string(src[:len(prefix)]) != prefix || src[size-1] != ':' {
+		return NoSkinTone, 0, true
+	}
+	tone := SkinTone(src[len(prefix)]-'0') - 1
+	if tone < SkinToneLight || tone > SkinToneDark {
+		return NoSkinTone, 0, true
+	}
+	return tone, size, true
+}
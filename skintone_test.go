@@ -0,0 +1,83 @@
+package emoji
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSkinToneShortCodes(t *testing.T) {
+	r := NewReplacer(WithPadding(""))
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{":+1::skin-tone-4:", "\U0001f44d\U0001f3fd"},
+		{":+1_tone3:", "\U0001f44d\U0001f3fd"},
+		{":point_up::skin-tone-2:", "\u261d\U0001f3fb"},
+		{":farmer_tone5:", "\U0001f9d1\U0001f3ff\u200d\U0001f33e"},
+		{":beer::skin-tone-4:", "\U0001f37a\U0001f3fd"},
+		{":beer_tone3:", ":beer_tone3:"},
+		{":thumbsup_tone1::skin-tone-6:", "\U0001f44d\U0001f3fb\U0001f3ff"},
+		{":+1::skin-tone-7:", "\U0001f44d:skin-tone-7:"},
+		{":+1::skin", "\U0001f44d:skin"},
+		{":+1_tone6:", ":+1_tone6:"},
+	}
+	for _, tt := range tests {
+		if actual := r.Replace(tt.in); actual != tt.expected {
+			t.Errorf("Replace(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+
+	in := "I :+1::skin-tone-4: it"
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for i := 0; i < len(in); i++ {
+		if _, err := w.Write([]byte{in[i]}); err != nil {
+			t.Fatal("Write ", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal("Close ", err)
+	}
+	if buf.String() != Sprint(in) || buf.String() != "I \U0001f44d\U0001f3fd  it" {
+		t.Errorf("Writer %q", buf.String())
+	}
+}
+
+func TestSplitSkinTone(t *testing.T) {
+	tests := []struct {
+		in   string
+		base string
+		tone SkinTone
+	}{
+		{"\U0001f44d\U0001f3fd", "\U0001f44d", SkinToneMedium},
+		{"\U0001f9d1\U0001f3ff\u200d\U0001f33e", "\U0001f9d1\u200d\U0001f33e", SkinToneDark},
+		{"\U0001f44d", "\U0001f44d", NoSkinTone},
+		{"\U0001f37a\U0001f3fd", "\U0001f37a\U0001f3fd", NoSkinTone},
+	}
+	for _, tt := range tests {
+		base, tone := SplitSkinTone(tt.in)
+		if base != tt.base || tone != tt.tone {
+			t.Errorf("SplitSkinTone(%q) = %q, %d, expected %q, %d", tt.in, base, tone, tt.base, tt.tone)
+		}
+	}
+	if SkinToneLight.Modifier() != "\U0001f3fb" || NoSkinTone.Modifier() != "" {
+		t.Error("Modifier")
+	}
+}
+
+func TestDemojizeSkinTone(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"\U0001f44d\U0001f3fd", ":thumbsup_tone3:"},
+		{"\U0001faf0\U0001f3fd", NormalizeShortCode(":hand_with_index_finger_and_thumb_crossed:") + ":skin-tone-4:"},
+		{"\U0001f9d1\U0001f3fd\u200d\U0001f33e!", NormalizeShortCode(":farmer:") + ":skin-tone-4:!"},
+	}
+	for _, tt := range tests {
+		if actual := Demojize(tt.in); actual != tt.expected {
+			t.Errorf("Demojize(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+}
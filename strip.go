@@ -0,0 +1,144 @@
+package emoji
+
+import "unicode/utf8"
+
+const (
+	variationSelector15 = '\ufe0e'
+	zeroWidthJoiner     = '\u200d'
+	combiningKeycap     = '\u20e3'
+)
+
+// WithCollapseSpace makes Strip collapse the spaces and tabs left around removed
+// emoji, so "ship it :rocket: now" becomes "ship it now". Whitespace at the start
+// and end of a line is dropped along with the emoji. Other whitespace is left alone.
+func WithCollapseSpace() Option {
+	return func(r *Replacer) {
+		r.collapseSpace = true
+	}
+}
+
+// Strip removes every shortcode and emoji from s: the shortcodes that Replace would
+// replace, and emoji sequences in the text, including ZWJ sequences, skin tones,
+// variation selectors, keycaps and flags. Stray modifiers, variation selectors 16,
+// tags and regional indicators are removed as well. Escaped shortcodes are kept
+// without their backslash, as Replace keeps them.
+func Strip(s string) string {
+	return defaultReplacer.Strip(s)
+}
+
+// Strip is Strip using the settings of r. Shortcodes of emoji newer than the version
+// set with WithMaxEmojiVersion are removed like the others.
+func (r *Replacer) Strip(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	stripper := *r
+	stripper.maxVersion = nil
+	sc := scanner{r: &stripper, strip: true}
+	output, _ := sc.scan(make([]byte, 0, len(s)), []byte(s), true)
+	return string(stripEmoji(make([]byte, 0, len(output)), output, sc.removed, r.collapseSpace))
+}
+
+// stripEmoji appends src without its emoji sequences to dst. removed lists the
+// offsets in src where shortcodes were removed, which collapse treats like emoji.
+func stripEmoji(dst, src []byte, removed []int, collapse bool) []byte {
+	trie := currentTables().sequenceTrie()
+	text := string(src)
+
+	// pending is set after a removal, up to the next character that is not a space
+	pending := false
+	for i := 0; ; {
+		for len(removed) > 0 && removed[0] <= i {
+			removed = removed[1:]
+			pending = true
+		}
+		if i == len(src) {
+			break
+		}
+		if n := emojiSequenceLen(trie, text[i:]); n > 0 {
+			i += n
+			pending = true
+			continue
+		}
+
+		r, n := utf8.DecodeRune(src[i:])
+		i += n
+		if pending && collapse {
+			switch {
+			case r == ' ' || r == '\t':
+				if len(dst) == 0 || isSpaceByte(dst[len(dst)-1]) {
+					continue
+				}
+			case r == '\n' || r == '\r':
+				dst = trimSpaceRight(dst)
+			}
+		}
+		pending = pending && (r == ' ' || r == '\t')
+		dst = append(dst, src[i-n:i]...)
+	}
+	if pending && collapse {
+		dst = trimSpaceRight(dst)
+	}
+	return dst
+}
+
+// emojiSequenceLen returns the length in bytes of the emoji sequence at the start of
+// s, or 0 if s does not start with one. Besides the sequences with shortcodes, it
+// matches characters with emoji presentation, joined by ZWJ and followed by
+// modifiers, variation selectors, tags and keycaps, and stray components.
+func emojiSequenceLen(trie *emojiTrie, s string) int {
+	_, n := trie.match(s)
+	if n == 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		switch {
+		case isEmojiComponent(r):
+			return size
+		case !HasProperty(r, PropertyEmojiPresentation):
+			return 0
+		}
+		n = size
+	}
+
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		switch {
+		case r == variationSelector15, r == variationSelector16, r == combiningKeycap,
+			skinToneOf(r) != NoSkinTone, isTag(r):
+			n += size
+		case r == zeroWidthJoiner:
+			next := emojiSequenceLen(trie, s[n+size:])
+			if next == 0 {
+				return n
+			}
+			return n + size + next
+		default:
+			return n
+		}
+	}
+	return n
+}
+
+// isEmojiComponent reports whether r only modifies emoji, so that it can be removed
+// on its own. Joiners and keycaps are not, as other scripts use them too.
+func isEmojiComponent(r rune) bool {
+	return r == variationSelector16 || skinToneOf(r) != NoSkinTone ||
+		isTag(r) || r >= '\U0001F1E6' && r <= '\U0001F1FF'
+}
+
+// isTag reports whether r is one of the tag characters of subdivision flags.
+func isTag(r rune) bool {
+	return r >= tagBase+' ' && r <= cancelTag
+}
+
+func isSpaceByte(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
+
+// trimSpaceRight removes the spaces and tabs at the end of b.
+func trimSpaceRight(b []byte) []byte {
+	for len(b) > 0 && (b[len(b)-1] == ' ' || b[len(b)-1] == '\t') {
+		b = b[:len(b)-1]
+	}
+	return b
+}
@@ -0,0 +1,50 @@
+package emoji
+
+import "testing"
+
+func TestStrip(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"ship it :rocket: now", "ship it  now"},
+		{"cheers\U0001f37a!", "cheers!"},
+		{"family \U0001f468\u200d\U0001f469\u200d\U0001f467 here", "family  here"},
+		{"wave\U0001f44b\U0001f3fd", "wave"},
+		{"love \u2764\ufe0f and \u2764", "love  and "},
+		{"flags \U0001f1ef\U0001f1f5\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "flags "},
+		{"key #\ufe0f\u20e3", "key "},
+		{"stray\ufe0f\U0001f3fb", "stray"},
+		{"new \U0001fae8 emoji", "new  emoji"},
+		{"time 12:30:45 :unknown:", "time 12:30:45 :unknown:"},
+		{`\:beer:`, ":beer:"},
+		{"\u0915\u094d\u200d\u0937", "\u0915\u094d\u200d\u0937"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if actual := Strip(tt.in); actual != tt.expected {
+			t.Errorf("Strip(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+}
+
+func TestWithCollapseSpace(t *testing.T) {
+	r := NewReplacer(WithCollapseSpace())
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"ship it :rocket: now", "ship it now"},
+		{":rocket: launch", "launch"},
+		{"done \U0001f389", "done"},
+		{"a \U0001f37a \U0001f37a\tb", "a b"},
+		{"line :beer: \nnext", "line\nnext"},
+		{"keep  these   spaces", "keep  these   spaces"},
+		{"x\U0001f37ay", "xy"},
+	}
+	for _, tt := range tests {
+		if actual := r.Strip(tt.in); actual != tt.expected {
+			t.Errorf("Strip(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package emoji
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// UnknownShortCodeError reports a shortcode ReplaceStrict could not replace.
+type UnknownShortCodeError struct {
+	// ShortCode is the unknown shortcode, such as ":thumbup:".
+	ShortCode string
+	// Offset is the byte offset of the shortcode in the input.
+	Offset int
+	// Suggestions are the known shortcodes closest to ShortCode, best first.
+	Suggestions []string
+}
+
+func (e *UnknownShortCodeError) Error() string {
+	if len(e.Suggestions) == 0 {
+		return fmt.Sprintf("emoji: unknown shortcode %s at offset %d", e.ShortCode, e.Offset)
+	}
+	return fmt.Sprintf("emoji: unknown shortcode %s at offset %d, did you mean %s?",
+		e.ShortCode, e.Offset, strings.Join(e.Suggestions, ", "))
+}
+
+// suggestionCount is the number of suggestions in an UnknownShortCodeError.
+const suggestionCount = 3
+
+// Suggest returns up to n known shortcodes that shortCode may be a typo of, best
+// first. shortCode may lack its colons, so Suggest(":thumbup:", 1) and
+// Suggest("thumbup", 1) both return [":thumbsup:"]. Shortcodes are ranked by edit
+// distance, by whether one is a prefix of the other, and by the words they share.
+// If n is negative, all of them are returned.
+func Suggest(shortCode string, n int) []string {
+	return suggest(CodeMap(), shortCode, n)
+}
+
+// ReplaceStrict is Sprint that also reports the shortcodes it could not replace.
+// Every colon-delimited word that is not a shortcode, and every shortcode missing its
+// closing colon, such as ":tada", is reported as an *UnknownShortCodeError with
+// suggestions. The errors are joined with errors.Join.
+func ReplaceStrict(s string) (string, error) {
+	return defaultReplacer.ReplaceStrict(s)
+}
+
+// ReplaceStrict is ReplaceStrict using the settings of r.
+func (r *Replacer) ReplaceStrict(s string) (string, error) {
+	var errs []error
+	sc := scanner{r: r, unknown: func(candidate string, offset int) {
+		if candidate[len(candidate)-1] != ':' {
+			// an unclosed candidate is only reported when it lacks just the colon
+			candidate = strings.TrimRightFunc(candidate, func(r rune) bool { return r != ':' && !isShortCodeRune(r) })
+			if _, _, ok := r.lookup(candidate + ":"); !ok || len(candidate) < 2 {
+				return
+			}
+		}
+		errs = append(errs, &UnknownShortCodeError{
+			ShortCode:   candidate,
+			Offset:      offset,
+			Suggestions: suggest(r.codes(), candidate, suggestionCount),
+		})
+	}}
+	output, _ := sc.scan(make([]byte, 0, len(s)), []byte(s), true)
+	return string(output), errors.Join(errs...)
+}
+
+// isShortCodeRune reports whether r may be part of the name of a shortcode.
+func isShortCodeRune(r rune) bool {
+	return isASCIIAlnum(r) || r == '_' || r == '-' || r == '+'
+}
+
+// suggest is Suggest for the shortcodes of codeMap.
+func suggest(codeMap map[string]string, shortCode string, n int) []string {
+	query := strings.ToLower(strings.Trim(shortCode, ":"))
+	if n == 0 || query == "" {
+		return nil
+	}
+	maxDistance := 1 + utf8.RuneCountInString(query)/4
+	queryWords := strings.FieldsFunc(query, isWordSeparator)
+
+	type suggestion struct {
+		shortCode string
+		distance  int
+		score     float64
+	}
+	var suggestions []suggestion
+	for known := range codeMap {
+		name := strings.ToLower(strings.Trim(known, ":"))
+		distance := editDistance(query, name)
+		prefix := len(query) >= 3 && len(name) >= 3 && (strings.HasPrefix(name, query) || strings.HasPrefix(query, name))
+		overlap := wordOverlap(queryWords, strings.FieldsFunc(name, isWordSeparator))
+		if distance > maxDistance && !prefix && overlap == 0 {
+			continue
+		}
+		score := float64(distance) - 2*overlap
+		if prefix {
+			score--
+		}
+		suggestions = append(suggestions, suggestion{known, distance, score})
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.score != b.score {
+			return a.score < b.score
+		}
+		if a.distance != b.distance {
+			return a.distance < b.distance
+		}
+		return a.shortCode < b.shortCode
+	})
+	if n > 0 && len(suggestions) > n {
+		suggestions = suggestions[:n]
+	}
+	shortCodes := make([]string, len(suggestions))
+	for i, s := range suggestions {
+		shortCodes[i] = s.shortCode
+	}
+	return shortCodes
+}
+
+func isWordSeparator(r rune) bool {
+	return r == '_' || r == '-'
+}
+
+// wordOverlap returns the share of the words of a and b that both have, from 0 to 1.
+func wordOverlap(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for _, word := range a {
+		for _, other := range b {
+			if word == other {
+				shared++
+				break
+			}
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// editDistance returns the number of insertions, deletions, substitutions and
+// transpositions of adjacent runes that turn a into b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	// three rows of the distance matrix suffice
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,85 @@
+package emoji
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSuggest(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{":thumbup:", ":thumbsup:"},
+		{":tada", ":tada:"},
+		{"smiel", ":smile:"},
+		{"red_hart", ":red_heart:"},
+		{"pizz", ":pizza:"},
+		{"christmas_tre", ":Christmas_tree:"},
+	}
+	for _, tt := range tests {
+		if actual := Suggest(tt.in, 3); len(actual) == 0 || actual[0] != tt.expected {
+			t.Errorf("Suggest(%q) = %q, expected %q first", tt.in, actual, tt.expected)
+		}
+	}
+	if actual := Suggest(":xyzzyq:", 3); len(actual) != 0 {
+		t.Errorf("Suggest(:xyzzyq:) = %q", actual)
+	}
+	if actual := Suggest(":smile:", 0); actual != nil {
+		t.Errorf("Suggest(:smile:, 0) = %q", actual)
+	}
+	if actual := Suggest(":smile:", 2); len(actual) != 2 {
+		t.Errorf("Suggest(:smile:, 2) = %q", actual)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"beer", "", 4},
+		{"smile", "smiel", 1},
+		{"thumbsup", "thumbup", 1},
+		{"kitten", "sitting", 3},
+		{"ビール", "ビル", 1},
+	}
+	for _, tt := range tests {
+		if actual := editDistance(tt.a, tt.b); actual != tt.expected {
+			t.Errorf("editDistance(%q, %q) = %d, expected %d", tt.a, tt.b, actual, tt.expected)
+		}
+	}
+}
+
+func TestReplaceStrict(t *testing.T) {
+	output, err := ReplaceStrict("a :thumbup: b :tada c :beer: 10:30 :tada")
+	if expected := "a :thumbup: b :tada c " + Emojize(beerKey) + " 10:30 :tada"; output != expected {
+		t.Errorf("ReplaceStrict = %q, expected %q", output, expected)
+	}
+
+	var unknown []*UnknownShortCodeError
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var e *UnknownShortCodeError
+		if !errors.As(err, &e) {
+			t.Fatalf("ReplaceStrict error %v", err)
+		}
+		unknown = append(unknown, e)
+	}
+	if len(unknown) != 3 {
+		t.Fatalf("ReplaceStrict errors %v", err)
+	}
+	if e := unknown[0]; e.ShortCode != ":thumbup:" || e.Offset != 2 || e.Suggestions[0] != ":thumbsup:" {
+		t.Errorf("ReplaceStrict error %+v", e)
+	}
+	if e := unknown[1]; e.ShortCode != ":tada" || e.Offset != 14 || e.Suggestions[0] != ":tada:" {
+		t.Errorf("ReplaceStrict error %+v", e)
+	}
+	if e := unknown[2]; e.ShortCode != ":tada" || e.Offset != 35 {
+		t.Errorf("ReplaceStrict error %+v", e)
+	}
+
+	if _, err := ReplaceStrict(":beer: 12:30:45 :"); err != nil {
+		t.Errorf("ReplaceStrict error %v", err)
+	}
+}
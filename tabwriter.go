@@ -0,0 +1,318 @@
+package emoji
+
+import (
+	"io"
+	"text/tabwriter"
+)
+
+// TabWriter is a text/tabwriter.Writer that measures cells with StringWidth, so that
+// columns with emoji and ANSI escape sequences line up:
+//
+//	w := emoji.NewTabWriter(os.Stdout, 0, 8, 1, ' ', 0)
+//	emoji.Fprintln(w, ":beer:\tbeer")
+//	emoji.Fprintln(w, ":family_man_woman_girl:\tfamily")
+//	w.Flush()
+//
+// It takes the flags of text/tabwriter, such as tabwriter.AlignRight, and formats
+// text the same way otherwise.
+type TabWriter struct {
+	output   io.Writer
+	minwidth int
+	tabwidth int
+	padding  int
+	padbytes [8]byte
+	flags    uint
+
+	// buf holds the text of the cells since the last flush, without the tabs and
+	// line breaks. cell is the cell being written, whose width is up to date up to
+	// pos in buf. endChar ends the escaped text being written, if any.
+	buf     []byte
+	pos     int
+	cell    tabCell
+	endChar byte
+
+	// lines holds the cells of each line, and widths the widths of the columns being
+	// formatted.
+	lines  [][]tabCell
+	widths []int
+
+	err error
+}
+
+// tabCell is a cell of text, terminated by a tab unless it is the last of its line.
+type tabCell struct {
+	size  int
+	width int
+	htab  bool
+}
+
+// NewTabWriter returns a TabWriter configured like tabwriter.NewWriter.
+func NewTabWriter(output io.Writer, minwidth, tabwidth, padding int, padchar byte, flags uint) *TabWriter {
+	return new(TabWriter).Init(output, minwidth, tabwidth, padding, padchar, flags)
+}
+
+// Init initializes w like tabwriter.Writer.Init.
+func (w *TabWriter) Init(output io.Writer, minwidth, tabwidth, padding int, padchar byte, flags uint) *TabWriter {
+	if minwidth < 0 || tabwidth < 0 || padding < 0 {
+		panic("negative minwidth, tabwidth, or padding")
+	}
+	w.output = output
+	w.minwidth = minwidth
+	w.tabwidth = tabwidth
+	w.padding = padding
+	for i := range w.padbytes {
+		w.padbytes[i] = padchar
+	}
+	if padchar == '\t' {
+		// tab padding enforces left-alignment
+		flags &^= tabwriter.AlignRight
+	}
+	w.flags = flags
+	w.err = nil
+	w.reset()
+	return w
+}
+
+func (w *TabWriter) reset() {
+	w.buf = w.buf[:0]
+	w.pos = 0
+	w.cell = tabCell{}
+	w.endChar = 0
+	w.lines = w.lines[:0]
+	w.widths = w.widths[:0]
+	w.addLine()
+}
+
+func (w *TabWriter) addLine() {
+	w.lines = append(w.lines, []tabCell{})
+}
+
+// Write writes buf to w. Text is buffered until a line without tabs, a form feed or
+// a call to Flush ends the block of lines a column spans.
+func (w *TabWriter) Write(buf []byte) (int, error) {
+	n := 0
+	for i, ch := range buf {
+		if w.endChar != 0 {
+			if ch == w.endChar {
+				j := i + 1
+				if ch == tabwriter.Escape && w.flags&tabwriter.StripEscape != 0 {
+					j = i
+				}
+				w.append(buf[n:j])
+				n = i + 1
+				w.endEscape()
+			}
+			continue
+		}
+
+		switch ch {
+		case '\t', '\v', '\n', '\f':
+			w.append(buf[n:i])
+			w.updateWidth()
+			n = i + 1
+			cells := w.terminateCell(ch == '\t')
+			if ch == '\n' || ch == '\f' {
+				w.addLine()
+				if ch == '\f' || cells == 1 {
+					// a line of one cell does not affect the columns of the next lines
+					w.flush()
+					if ch == '\f' && w.flags&tabwriter.Debug != 0 {
+						w.write([]byte("---\n"))
+					}
+				}
+			}
+		case tabwriter.Escape:
+			w.append(buf[n:i])
+			w.updateWidth()
+			n = i
+			if w.flags&tabwriter.StripEscape != 0 {
+				n++
+			}
+			w.endChar = tabwriter.Escape
+		case '<', '&':
+			if w.flags&tabwriter.FilterHTML != 0 {
+				w.append(buf[n:i])
+				w.updateWidth()
+				n = i
+				w.endChar = '>'
+				if ch == '&' {
+					w.endChar = ';'
+				}
+			}
+		}
+	}
+	w.append(buf[n:])
+
+	if w.err != nil {
+		return 0, w.err
+	}
+	return len(buf), nil
+}
+
+// Flush writes the buffered text. It must be called after the last Write.
+func (w *TabWriter) Flush() error {
+	w.flush()
+	return w.err
+}
+
+func (w *TabWriter) flush() {
+	if w.cell.size > 0 {
+		if w.endChar != 0 {
+			w.endEscape()
+		}
+		w.terminateCell(false)
+	}
+	w.format(0, 0, len(w.lines))
+	w.reset()
+}
+
+func (w *TabWriter) append(text []byte) {
+	w.buf = append(w.buf, text...)
+	w.cell.size += len(text)
+}
+
+// updateWidth adds the width of the text written since the last update to the cell.
+// Control characters, which escaped text may contain, take one column as they do in
+// text/tabwriter.
+func (w *TabWriter) updateWidth() {
+	w.cell.width += stringWidth(string(w.buf[w.pos:]), 1)
+	w.pos = len(w.buf)
+}
+
+// endEscape ends escaped text: escaped text is as wide as it is without the escape
+// characters, HTML tags take no space and HTML entities one column.
+func (w *TabWriter) endEscape() {
+	switch w.endChar {
+	case tabwriter.Escape:
+		w.updateWidth()
+		if w.flags&tabwriter.StripEscape == 0 {
+			w.cell.width -= 2
+		}
+	case ';':
+		w.cell.width++
+	}
+	w.pos = len(w.buf)
+	w.endChar = 0
+}
+
+// terminateCell adds the current cell to the current line and returns the number of
+// cells of the line.
+func (w *TabWriter) terminateCell(htab bool) int {
+	w.cell.htab = htab
+	line := &w.lines[len(w.lines)-1]
+	*line = append(*line, w.cell)
+	w.cell = tabCell{}
+	return len(*line)
+}
+
+// format writes lines line0 to line1, whose text starts at pos0 in buf, and returns
+// the position of the text that follows. Each block of consecutive lines with a cell
+// in the next column is formatted with the width of that column.
+func (w *TabWriter) format(pos0, line0, line1 int) int {
+	pos := pos0
+	column := len(w.widths)
+	for this := line0; this < line1; this++ {
+		if column >= len(w.lines[this])-1 {
+			continue
+		}
+
+		// this line starts a block with a cell in the column
+		pos = w.writeLines(pos, line0, this)
+		line0 = this
+
+		width := w.minwidth
+		discardable := true
+		for ; this < line1; this++ {
+			line := w.lines[this]
+			if column >= len(line)-1 {
+				break
+			}
+			c := line[column]
+			width = max(width, c.width+w.padding)
+			if c.width > 0 || c.htab {
+				discardable = false
+			}
+		}
+		if discardable && w.flags&tabwriter.DiscardEmptyColumns != 0 {
+			width = 0
+		}
+
+		w.widths = append(w.widths, width)
+		pos = w.format(pos, line0, this)
+		w.widths = w.widths[:len(w.widths)-1]
+		line0 = this
+	}
+	return w.writeLines(pos, line0, line1)
+}
+
+func (w *TabWriter) writeLines(pos0, line0, line1 int) int {
+	pos := pos0
+	for i := line0; i < line1; i++ {
+		useTabs := w.flags&tabwriter.TabIndent != 0
+		for j, c := range w.lines[i] {
+			if j > 0 && w.flags&tabwriter.Debug != 0 {
+				w.write([]byte{'|'})
+			}
+
+			switch {
+			case c.size == 0:
+				if j < len(w.widths) {
+					w.writePadding(c.width, w.widths[j], useTabs)
+				}
+			case w.flags&tabwriter.AlignRight == 0:
+				useTabs = false
+				w.write(w.buf[pos : pos+c.size])
+				pos += c.size
+				if j < len(w.widths) {
+					w.writePadding(c.width, w.widths[j], false)
+				}
+			default:
+				useTabs = false
+				if j < len(w.widths) {
+					w.writePadding(c.width, w.widths[j], false)
+				}
+				w.write(w.buf[pos : pos+c.size])
+				pos += c.size
+			}
+		}
+
+		if i+1 == len(w.lines) {
+			// the last line has no line break yet
+			w.write(w.buf[pos : pos+w.cell.size])
+			pos += w.cell.size
+		} else {
+			w.write([]byte{'\n'})
+		}
+	}
+	return pos
+}
+
+func (w *TabWriter) writePadding(textw, cellw int, useTabs bool) {
+	if w.padbytes[0] == '\t' || useTabs {
+		if w.tabwidth == 0 {
+			return
+		}
+		// make cellw the smallest multiple of tabwidth
+		cellw = (cellw + w.tabwidth - 1) / w.tabwidth * w.tabwidth
+		w.writeN([]byte("\t\t\t\t\t\t\t\t"), (cellw-textw+w.tabwidth-1)/w.tabwidth)
+		return
+	}
+	w.writeN(w.padbytes[:], cellw-textw)
+}
+
+func (w *TabWriter) writeN(src []byte, n int) {
+	for n > len(src) {
+		w.write(src)
+		n -= len(src)
+	}
+	if n > 0 {
+		w.write(src[:n])
+	}
+}
+
+// write writes to the output, unless an earlier write failed.
+func (w *TabWriter) write(p []byte) {
+	if w.err == nil {
+		_, w.err = w.output.Write(p)
+	}
+}
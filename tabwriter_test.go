@@ -0,0 +1,69 @@
+package emoji
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"text/tabwriter"
+)
+
+func TestTabWriterAlignsEmoji(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewTabWriter(&buf, 0, 8, 1, ' ', 0)
+	r := NewReplacer(WithPadding(""))
+	r.Fprintln(w, ":beer:\tbeer\t1")
+	r.Fprintln(w, ":family_man_woman_girl:\tfamily\t2")
+	r.Fprintln(w, "none\t-\t3")
+	r.Fprintln(w, "\x1b[31mred\x1b[0m\tcolor\t4")
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "\U0001f37a   beer   1\n" +
+		"\U0001f468\u200d\U0001f469\u200d\U0001f467   family 2\n" +
+		"none -      3\n" +
+		"\x1b[31mred\x1b[0m  color  4\n"
+	if buf.String() != expected {
+		t.Errorf("TabWriter wrote %q, expected %q", buf.String(), expected)
+	}
+}
+
+func TestTabWriterLikeTabwriter(t *testing.T) {
+	inputs := []string{
+		"a\tb\tc\naaa\tbbbbb\tc\nx\n\ty\tz\n",
+		"name\tvalue\n\tindented\n\n\tlast\tcell",
+		"a\t\tb\n\t\tc\nd\ve\ff\tg\n",
+		"\xffa\tb\xff\tc\nxyz\t\xff\t\xff\n",
+		"<b>bold</b>\t&amp;\tx\nplain\ty\tz\n",
+		"trailing\t",
+	}
+	flagSets := []uint{
+		0,
+		tabwriter.AlignRight,
+		tabwriter.Debug,
+		tabwriter.DiscardEmptyColumns,
+		tabwriter.TabIndent,
+		tabwriter.FilterHTML,
+		tabwriter.StripEscape,
+		tabwriter.AlignRight | tabwriter.Debug | tabwriter.DiscardEmptyColumns,
+	}
+	for _, input := range inputs {
+		for _, flags := range flagSets {
+			for _, padchar := range []byte{' ', '.', '\t'} {
+				var expected, actual bytes.Buffer
+				tw := tabwriter.NewWriter(&expected, 4, 8, 2, padchar, flags)
+				w := NewTabWriter(&actual, 4, 8, 2, padchar, flags)
+				// write in pieces, as Fprint does
+				for _, piece := range strings.SplitAfter(input, "\t") {
+					tw.Write([]byte(piece))
+					w.Write([]byte(piece))
+				}
+				tw.Flush()
+				w.Flush()
+				if actual.String() != expected.String() {
+					t.Errorf("TabWriter(%q, %#x, %q) wrote %q, expected %q", input, flags, padchar, actual.String(), expected.String())
+				}
+			}
+		}
+	}
+}
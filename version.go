@@ -0,0 +1,73 @@
+package emoji
+
+import (
+	"strconv"
+	"strings"
+)
+
+// emojiVersion is an emoji version, such as 12.0.
+type emojiVersion struct {
+	major, minor int
+}
+
+// parseEmojiVersion parses versions such as "12.0", "12" and "0.6".
+func parseEmojiVersion(s string) (emojiVersion, bool) {
+	majorText, minorText, _ := strings.Cut(s, ".")
+	major, err := strconv.Atoi(majorText)
+	if err != nil || major < 0 {
+		return emojiVersion{}, false
+	}
+	minor := 0
+	if minorText != "" {
+		if minor, err = strconv.Atoi(minorText); err != nil || minor < 0 {
+			return emojiVersion{}, false
+		}
+	}
+	return emojiVersion{major, minor}, true
+}
+
+func (v emojiVersion) after(other emojiVersion) bool {
+	return v.major > other.major || v.major == other.major && v.minor > other.minor
+}
+
+// WithMaxEmojiVersion leaves emoji introduced after version, such as "12.0", as
+// shortcodes, for terminals whose fonts lack them. WithNewerEmojiFallback replaces
+// them with a fallback instead. Emoji newer than the metadata of this package, whose
+// version is unknown, count as newer as well. An invalid version is ignored.
+func WithMaxEmojiVersion(version string) Option {
+	return func(r *Replacer) {
+		if v, ok := parseEmojiVersion(version); ok {
+			r.maxVersion = &v
+		}
+	}
+}
+
+// WithNewerEmojiFallback sets the text that replaces the shortcodes of emoji newer
+// than the version set with WithMaxEmojiVersion.
+func WithNewerEmojiFallback(fallback string) Option {
+	return func(r *Replacer) {
+		r.newerFallback = &fallback
+	}
+}
+
+// tooNew reports whether an emoji is newer than the version set with
+// WithMaxEmojiVersion.
+func (r *Replacer) tooNew(code string) bool {
+	if r.maxVersion == nil {
+		return false
+	}
+	d, ok := lookupEmojiData(code)
+	if !ok {
+		return true
+	}
+	v, ok := parseEmojiVersion(d.version)
+	return !ok || v.after(*r.maxVersion)
+}
+
+// newerEmoji is the result of lookup for an emoji that is too new.
+func (r *Replacer) newerEmoji() (string, bool, bool) {
+	if r.newerFallback != nil {
+		return *r.newerFallback, false, true
+	}
+	return "", false, false
+}
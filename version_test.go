@@ -0,0 +1,50 @@
+package emoji
+
+import "testing"
+
+func TestWithMaxEmojiVersion(t *testing.T) {
+	r := NewReplacer(WithPadding(""), WithMaxEmojiVersion("12.0"))
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{":beer: :yawning_face:", "\U0001f37a \U0001f971"},
+		{":smiling_face_with_tear: :melting_face:", ":smiling_face_with_tear: :melting_face:"},
+		{":+1_tone2:", "\U0001f44d\U0001f3fc"},
+		{":flag-jp:", "\U0001f1ef\U0001f1f5"},
+		{`\:melting_face:`, `\:melting_face:`},
+	}
+	for _, tt := range tests {
+		if actual := r.Replace(tt.in); actual != tt.expected {
+			t.Errorf("Replace(%q) = %q, expected %q", tt.in, actual, tt.expected)
+		}
+	}
+
+	r = NewReplacer(WithPadding(" "), WithMaxEmojiVersion("13"), WithNewerEmojiFallback("?"))
+	if actual := r.Replace(":smiling_face_with_tear: :melting_face:"); actual != "\U0001f972  ?" {
+		t.Errorf("Replace with fallback %q", actual)
+	}
+	if actual := NewReplacer(WithMaxEmojiVersion("x")).Replace(":melting_face:"); actual != "\U0001fae0 " {
+		t.Errorf("Replace with an invalid version %q", actual)
+	}
+}
+
+func TestParseEmojiVersion(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected emojiVersion
+		ok       bool
+	}{
+		{"12.0", emojiVersion{12, 0}, true},
+		{"0.6", emojiVersion{0, 6}, true},
+		{"15", emojiVersion{15, 0}, true},
+		{"", emojiVersion{}, false},
+		{"E12.0", emojiVersion{}, false},
+		{"12.x", emojiVersion{}, false},
+	}
+	for _, tt := range tests {
+		if actual, ok := parseEmojiVersion(tt.in); actual != tt.expected || ok != tt.ok {
+			t.Errorf("parseEmojiVersion(%q) = %v, %v", tt.in, actual, ok)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package emoji
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+// StringWidth returns the number of columns s takes up in a terminal. Emoji sequences,
+// such as ZWJ sequences, flags and emoji with a skin tone, take two columns, unless a
+// variation selector 15 requests the text presentation of an emoji. Characters shown
+// as text by default, such as "©", take one column unless followed by a variation
+// selector 16. East Asian wide characters take two columns, and combining marks,
+// control characters and ANSI escape sequences none.
+func StringWidth(s string) int {
+	return stringWidth(s, 0)
+}
+
+// stringWidth is StringWidth, counting control characters as controlWidth columns.
+func stringWidth(s string, controlWidth int) int {
+	trie := currentTables().sequenceTrie()
+
+	w := 0
+	for i := 0; i < len(s); {
+		if n := ansiEscapeLen(s[i:]); n > 0 {
+			i += n
+			continue
+		}
+		if n := emojiSequenceLen(trie, s[i:]); n > 0 {
+			w += emojiSequenceWidth(s[i : i+n])
+			i += n
+			continue
+		}
+		r, n := utf8.DecodeRuneInString(s[i:])
+		if isControl(r) {
+			w += controlWidth
+		} else {
+			w += runeWidth(r)
+		}
+		i += n
+	}
+	return w
+}
+
+// emojiSequenceWidth returns the number of columns an emoji sequence matched by
+// emojiSequenceLen takes up.
+func emojiSequenceWidth(seq string) int {
+	r, n := utf8.DecodeRuneInString(seq)
+	switch next, _ := utf8.DecodeRuneInString(seq[n:]); {
+	case n == len(seq):
+		return runeWidth(r)
+	case next == variationSelector15 && !strings.ContainsRune(seq, zeroWidthJoiner):
+		// text presentation
+		return runeWidth(r)
+	case isWide(seq), hasSkinTone(seq), strings.ContainsRune(seq, zeroWidthJoiner):
+		return 2
+	}
+	// a keycap or a character with text presentation
+	return runeWidth(r)
+}
+
+// runeWidth returns the number of columns a character takes up on its own.
+func runeWidth(r rune) int {
+	switch {
+	case isControl(r), unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case HasProperty(r, PropertyEmojiPresentation) && !isRegionalIndicator(r):
+		return 2
+	}
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	}
+	return 1
+}
+
+func isControl(r rune) bool {
+	return r < 0x20 || r >= 0x7f && r < 0xa0
+}
+
+const escapeChar = '\x1b'
+
+// ansiEscapeLen returns the length in bytes of the ANSI escape sequence at the start
+// of s, such as a color "\x1b[31m" or a hyperlink "\x1b]8;;url\x1b\\", or 0 if s does
+// not start with one. An unterminated sequence extends to the end of s.
+func ansiEscapeLen(s string) int {
+	if len(s) == 0 || s[0] != escapeChar {
+		return 0
+	}
+	if len(s) == 1 {
+		return 1
+	}
+	switch s[1] {
+	case '[':
+		// control sequence: parameters and intermediates followed by a final byte
+		for i := 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1
+			}
+			if s[i] < 0x20 || s[i] > 0x3f {
+				return i
+			}
+		}
+		return len(s)
+	case ']', 'P', 'X', '^', '_':
+		// string terminated by BEL or ST
+		for i := 2; i < len(s); i++ {
+			switch {
+			case s[i] == '\a':
+				return i + 1
+			case s[i] == escapeChar && i+1 < len(s) && s[i+1] == '\\':
+				return i + 2
+			}
+		}
+		return len(s)
+	}
+	_, n := utf8.DecodeRuneInString(s[1:])
+	return 1 + n
+}
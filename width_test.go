@@ -0,0 +1,36 @@
+package emoji
+
+import "testing"
+
+func TestStringWidth(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected int
+	}{
+		{"", 0},
+		{"beer", 4},
+		{"\U0001f37a", 2},
+		{"\U0001f37a beer", 7},
+		{"\U0001f468\u200d\U0001f469\u200d\U0001f467", 2},
+		{"\U0001f44b\U0001f3fd", 2},
+		{"\U0001f1ef\U0001f1f5", 2},
+		{"\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", 2},
+		{"#\ufe0f\u20e3", 2},
+		{"©", 1},
+		{"©\ufe0f", 2},
+		{"❤", 1},
+		{"❤\ufe0f", 2},
+		{"❤\ufe0e", 1},
+		{"日本", 4},
+		{"é", 1},
+		{"\x1b[31mred\x1b[0m", 3},
+		{"\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", 4},
+		{"\x1b[1m\U0001f37a\x1b[m", 2},
+		{"12", 2},
+	}
+	for _, tt := range tests {
+		if actual := StringWidth(tt.in); actual != tt.expected {
+			t.Errorf("StringWidth(%q) = %d, expected %d", tt.in, actual, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package emoji
+
+import (
+	"io"
+)
+
+// writer replaces shortcodes in everything written to it before passing it on to the
+// underlying io.Writer. A shortcode split across several Write calls is buffered
+// until it is complete.
+type writer struct {
+	w   io.Writer
+	s   scanner
+	buf []byte
+	out []byte
+	err error
+}
+
+// NewWriter returns a writer that emojizes its input and writes it to w.
+// Close must be called to flush an incomplete shortcode at the end of the stream;
+// it does not close w.
+func NewWriter(w io.Writer) io.WriteCloser {
+	return defaultReplacer.NewWriter(w)
+}
+
+// Write emojizes p and writes it to the underlying writer. Input that may still be
+// part of a shortcode is held back, never more than the longest known shortcode.
+func (w *writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	w.buf = append(w.buf, p...)
+	if err := w.flush(false); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close writes any buffered input as is.
+func (w *writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	return w.flush(true)
+}
+
+func (w *writer) flush(atEOF bool) error {
+	var n int
+	w.out, n = w.s.scan(w.out[:0], w.buf, atEOF)
+	w.buf = w.buf[:copy(w.buf, w.buf[n:])]
+	if len(w.out) == 0 {
+		return nil
+	}
+	if _, err := w.w.Write(w.out); err != nil {
+		w.err = err
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package emoji
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWriter(t *testing.T) {
+	inputs := []string{
+		beerKey + beerText,
+		"A :smile: and another: :smile:",
+		"::smile: :flag-us: :unknown: :+1:",
+		"ends inside :bee",
+		"time 12:30:45 and :" + strings.Repeat("x", 200) + ":beer: tail",
+	}
+	for _, in := range inputs {
+		expected := Sprint(in)
+		for size := 1; size <= len(in); size++ {
+			var buf bytes.Buffer
+			w := NewWriter(&buf)
+			for i := 0; i < len(in); i += size {
+				end := i + size
+				if end > len(in) {
+					end = len(in)
+				}
+				if _, err := w.Write([]byte(in[i:end])); err != nil {
+					t.Fatal("Write ", err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal("Close ", err)
+			}
+			if buf.String() != expected {
+				t.Errorf("Writer chunk size %d: %q != %q", size, buf.String(), expected)
+			}
+		}
+	}
+}
+
+func TestWriterBuffersAtMostOneShortCode(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	in := ":" + strings.Repeat("a", 4*shortCodeMaxLen())
+	if _, err := w.Write([]byte(in)); err != nil {
+		t.Fatal("Write ", err)
+	}
+	if pending := len(in) - buf.Len(); pending > shortCodeMaxLen() {
+		t.Errorf("Writer holds %d bytes, expected at most %d", pending, shortCodeMaxLen())
+	}
+}
+
+type errWriter struct{}
+
+func (errWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestWriterError(t *testing.T) {
+	w := NewWriter(errWriter{})
+	if _, err := w.Write([]byte(beerKey + beerText)); err == nil {
+		t.Error("Write expected error")
+	}
+	if err := w.Close(); err == nil {
+		t.Error("Close expected error")
+	}
+}